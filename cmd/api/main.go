@@ -14,11 +14,13 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // mülakat timezone'ları: sistemde zoneinfo olmasa da IANA isimleri çözülsün
 
 	"github.com/go-chi/chi/v5"
 	"github.com/joho/godotenv"
@@ -31,6 +33,7 @@ import (
 	"github.com/Ali0NAL/talentpass/internal/config"
 	"github.com/Ali0NAL/talentpass/internal/db"
 	httpx "github.com/Ali0NAL/talentpass/internal/http"
+	"github.com/Ali0NAL/talentpass/internal/storage"
 )
 
 func main() {
@@ -46,6 +49,12 @@ func main() {
 	}
 	defer pool.Close()
 
+	// Ek dosya deposu
+	store, err := openStorage(cfg)
+	if err != nil {
+		log.Fatal().Err(err).Str("driver", cfg.StorageDriver).Msg("storage init failed")
+	}
+
 	// Base router + health
 	r := httpx.NewBaseRouter()
 
@@ -68,18 +77,50 @@ func main() {
 		ah := httpx.NewAuthHandler(pool)
 		r.Mount("/auth", ah.Router())
 
+		at := httpx.NewAttachmentsHandler(pool, store, cfg.AttachmentMaxBytes, cfg.DownloadURLTTL)
+		// indirme imzalı link ile açılır (auth yok)
+		r.Mount("/attachments", at.Router())
+
+		// feed token ile açılır, token yönetimi kendi içinde RequireAuth kullanır
+		ch := httpx.NewCalendarHandler(pool)
+		r.Mount("/calendar", ch.Router())
+
 		r.Group(func(pr chi.Router) {
 			pr.Use(httpx.RequireAuth)
 
 			jh := httpx.NewJobsHandler(pool)
 			pr.Mount("/jobs", jh.Router())
+			// koleksiyon aksiyonları (/v1/jobs:xxx) Mount altına düşmez
+			pr.Post("/jobs:bulkImport", jh.BulkImport)
+			pr.Get("/jobs:export", jh.Export)
 
 			ap := httpx.NewApplicationsHandler(pool)
 			pr.Mount("/applications", ap.Router())
+			pr.Post("/applications:batch", ap.Batch)
+			pr.Mount("/applications/{id}/attachments", at.ApplicationRouter())
+
+			of := httpx.NewOffersHandler(pool, cfg.FXRates)
+			pr.Mount("/applications/{id}/offers", of.ApplicationRouter())
+			pr.Mount("/offers", of.Router())
+
+			lh := httpx.NewLabelsHandler(pool)
+			pr.Mount("/labels", lh.Router())
+
+			ct := httpx.NewContactsHandler(pool)
+			pr.Mount("/contacts", ct.Router())
+
+			sh := httpx.NewStatsHandler(pool)
+			pr.Mount("/stats", sh.Router())
 
 			oh := httpx.NewOrgsHandler(pool)
 			pr.Mount("/orgs", oh.Router())
 
+			st := httpx.NewSettingsHandler(pool)
+			pr.Mount("/settings", st.Router())
+
+			pf := httpx.NewProfileHandler(pool)
+			pr.Mount("/me", pf.Router())
+
 		})
 	})
 
@@ -110,3 +151,14 @@ func main() {
 	}
 	log.Info().Msg("bye 👋")
 }
+
+func openStorage(cfg config.Config) (storage.Storage, error) {
+	switch cfg.StorageDriver {
+	case "s3":
+		return storage.NewS3(cfg.S3Endpoint, cfg.S3Region, cfg.S3Bucket, cfg.S3AccessKey, cfg.S3SecretKey)
+	case "local", "":
+		return storage.NewLocalFS(cfg.StorageDir)
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_DRIVER %q (local|s3)", cfg.StorageDriver)
+	}
+}
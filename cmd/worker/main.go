@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/Ali0NAL/talentpass/internal/config"
+	"github.com/Ali0NAL/talentpass/internal/db"
+	"github.com/Ali0NAL/talentpass/internal/mail"
+	"github.com/Ali0NAL/talentpass/internal/worker"
+)
+
+// worker: arka plan işleri (next_action_at hatırlatmaları, ghosting tespiti). API'den bağımsız
+// ölçeklenebilir; birden fazla instance aynı anda çalışabilir.
+func main() {
+	_ = godotenv.Load()
+	zerolog.TimeFieldFormat = time.RFC3339
+
+	cfg := config.Load()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.Open(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatal().Err(err).Msg("db connect failed")
+	}
+	defer pool.Close()
+
+	sender := mail.NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.MailFrom)
+	rw := worker.NewReminderWorker(pool, sender, cfg.ReminderInterval)
+	gw := worker.NewGhostingWorker(pool, sender, cfg.GhostingInterval)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		log.Info().Dur("interval", cfg.ReminderInterval).Msg("reminder worker starting")
+		rw.Run(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		log.Info().Dur("interval", cfg.GhostingInterval).Msg("ghosting worker starting")
+		gw.Run(ctx)
+	}()
+	wg.Wait()
+	log.Info().Msg("bye 👋")
+}
@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// NewCalendarToken: takvim abonelik linki için gizli token (URL'de taşınır, DB'de hash'i saklanır)
+func NewCalendarToken() (plain string, hash string, err error) {
+	b := make([]byte, 24)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", err
+	}
+	plain = base64.RawURLEncoding.EncodeToString(b)
+	return plain, HashCalendarToken(plain), nil
+}
+
+func HashCalendarToken(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
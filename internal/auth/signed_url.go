@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"time"
+)
+
+// SignPath: path + son geçerlilik zamanı için HMAC imzası (JWT_SECRET ile).
+// İmzalı linkler Authorization header'ı olmadan (tarayıcı, e-posta) kullanılabilir.
+func SignPath(path string, expires time.Time) string {
+	m := hmac.New(sha256.New, jwtSecret)
+	m.Write([]byte("signed-url\n" + path + "\n" + strconv.FormatInt(expires.Unix(), 10)))
+	return base64.RawURLEncoding.EncodeToString(m.Sum(nil))
+}
+
+// VerifyPath: imza doğru ve süresi dolmamışsa true
+func VerifyPath(path string, expiresUnix int64, sig string, now time.Time) bool {
+	expires := time.Unix(expiresUnix, 0)
+	if now.After(expires) {
+		return false
+	}
+	return hmac.Equal([]byte(SignPath(path, expires)), []byte(sig))
+}
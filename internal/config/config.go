@@ -2,11 +2,41 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
 	Port        string
 	DatabaseURL string
+
+	// SMTP (docker-compose'da mailhog: localhost:1025)
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUser     string
+	SMTPPassword string
+	MailFrom     string
+
+	// worker: hatırlatma taraması aralığı
+	ReminderInterval time.Duration
+	// worker: hareketsiz (ghosted) başvuru taraması aralığı
+	GhostingInterval time.Duration
+
+	// ek dosyalar: STORAGE_DRIVER=local|s3
+	StorageDriver      string
+	StorageDir         string
+	S3Endpoint         string
+	S3Region           string
+	S3Bucket           string
+	S3AccessKey        string
+	S3SecretKey        string
+	AttachmentMaxBytes int64
+	DownloadURLTTL     time.Duration
+
+	// teklif karşılaştırma için statik kur tablosu: para birimi -> ortak baz
+	// cinsinden değer (FX_RATES="USD=1,EUR=1.08,...")
+	FXRates map[string]float64
 }
 
 func Load() Config {
@@ -22,5 +52,70 @@ func Load() Config {
 	return Config{
 		Port:        port,
 		DatabaseURL: dbURL,
+
+		SMTPHost:     getenv("SMTP_HOST", "localhost"),
+		SMTPPort:     getenv("SMTP_PORT", "1025"),
+		SMTPUser:     os.Getenv("SMTP_USER"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		MailFrom:     getenv("MAIL_FROM", "TalentPass <no-reply@talentpass.local>"),
+
+		ReminderInterval: getduration("REMINDER_INTERVAL", time.Minute),
+		GhostingInterval: getduration("GHOSTING_INTERVAL", time.Hour),
+
+		StorageDriver:      getenv("STORAGE_DRIVER", "local"),
+		StorageDir:         getenv("STORAGE_DIR", "./data/attachments"),
+		S3Endpoint:         os.Getenv("S3_ENDPOINT"),
+		S3Region:           getenv("S3_REGION", "us-east-1"),
+		S3Bucket:           os.Getenv("S3_BUCKET"),
+		S3AccessKey:        os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey:        os.Getenv("S3_SECRET_KEY"),
+		AttachmentMaxBytes: getint64("ATTACHMENT_MAX_BYTES", 10<<20),
+		DownloadURLTTL:     getduration("DOWNLOAD_URL_TTL", 15*time.Minute),
+
+		FXRates: getrates("FX_RATES", "USD=1,EUR=1.08,GBP=1.27,TRY=0.03"),
+	}
+}
+
+func getenv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func getint64(key string, def int64) int64 {
+	if n, err := strconv.ParseInt(os.Getenv(key), 10, 64); err == nil && n > 0 {
+		return n
+	}
+	return def
+}
+
+func getduration(key string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil && d > 0 {
+		return d
+	}
+	return def
+}
+
+// getrates: "KOD=oran,..." listesi; geçersiz girdiler atlanır, hiç geçerli girdi yoksa def kullanılır
+func getrates(key, def string) map[string]float64 {
+	parse := func(s string) map[string]float64 {
+		rates := map[string]float64{}
+		for _, kv := range strings.Split(s, ",") {
+			code, val, ok := strings.Cut(strings.TrimSpace(kv), "=")
+			if !ok {
+				continue
+			}
+			rate, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+			if err != nil || rate <= 0 {
+				continue
+			}
+			rates[strings.ToUpper(strings.TrimSpace(code))] = rate
+		}
+		return rates
+	}
+	if rates := parse(os.Getenv(key)); len(rates) > 0 {
+		return rates
 	}
+	return parse(def)
 }
@@ -0,0 +1,285 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+const maxBatchOps = 200
+
+// BatchReq: sırayla uygulanan işlemler. Varsayılan hep-ya-hiç: tek bir hata tüm batch'i
+// geri alır. partial=true ise başarısız işlem kendi savepoint'iyle geri alınır, diğerleri
+// commit olur.
+type BatchReq struct {
+	Partial    bool      `json:"partial"`
+	Operations []BatchOp `json:"operations"`
+}
+
+// BatchOp: op = status | delete | set_next_action_at | add_label | remove_label
+type BatchOp struct {
+	Op           string  `json:"op"`
+	ID           int64   `json:"id"`                       // application id
+	Status       string  `json:"status,omitempty"`         // op=status: hedef aşama
+	Note         *string `json:"note,omitempty"`           // op=status: timeline notu
+	NextActionAt *string `json:"next_action_at,omitempty"` // op=set_next_action_at: RFC3339, null temizler
+	LabelID      int64   `json:"label_id,omitempty"`       // op=add_label | remove_label
+}
+
+type batchResult struct {
+	Index       int               `json:"index"`
+	ID          int64             `json:"id"`
+	Op          string            `json:"op"`
+	OK          bool              `json:"ok"`
+	Changed     bool              `json:"changed"` // false: zaten istenen durumdaydı, event yazılmadı
+	Code        int               `json:"code"`    // tekil endpoint'in döneceği HTTP kodu
+	Error       string            `json:"error,omitempty"`
+	AllowedNext []string          `json:"allowed_next,omitempty"` // code=409 geçiş hatası: geçerli sonraki aşamalar
+	Application *repo.Application `json:"application,omitempty"`
+}
+
+// batchOpError: işleme özgü hata; Code tekil endpoint'teki karşılığı
+type batchOpError struct {
+	Code        int
+	Msg         string
+	AllowedNext []string
+}
+
+func (e *batchOpError) Error() string { return e.Msg }
+
+// @Summary      Batch update applications
+// @Description  Birden çok başvuruda tek transaction'da işlem: status (pipeline kuralıyla), delete (soft),
+// @Description  set_next_action_at, add_label / remove_label. İşlemler verilen sırayla uygulanır; her biri için sonuç döner.
+// @Description  Sahiplik tekil endpoint'lerdeki gibi kontrol edilir (başkasının başvurusu 404).
+// @Description  Değişen her satır için bir event yazılır. Varsayılan hep-ya-hiç: herhangi bir hata tüm batch'i geri alır (409).
+// @Description  partial=true: başarısız işlemler tek tek geri alınır, diğerleri commit olur (200).
+// @Tags         applications
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      BatchReq  true  "operations"
+// @Success      200   {object}  map[string]any
+// @Failure      400   {object}  map[string]string
+// @Failure      409   {object}  map[string]any
+// @Router       /v1/applications:batch [post]
+func (h *ApplicationsHandler) Batch(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req BatchReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	if len(req.Operations) == 0 {
+		writeError(w, http.StatusBadRequest, "operations required")
+		return
+	}
+	if len(req.Operations) > maxBatchOps {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("max %d operations", maxBatchOps))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	results := make([]batchResult, len(req.Operations))
+	failed := 0
+	for i, op := range req.Operations {
+		res := batchResult{Index: i, ID: op.ID, Op: op.Op}
+		app, changed, err := h.runBatchOp(ctx, tx, uid, op)
+		var opErr *batchOpError
+		switch {
+		case err == nil:
+			res.OK, res.Changed, res.Code = true, changed, http.StatusOK
+			if app.ID != 0 {
+				res.Application = &app
+			}
+		case errors.As(err, &opErr):
+			res.Code, res.Error, res.AllowedNext = opErr.Code, opErr.Msg, opErr.AllowedNext
+			failed++
+		default:
+			// bağlantı / timeout: batch'in geri kalanı da çalışamaz
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+		results[i] = res
+	}
+
+	if !req.Partial && failed > 0 {
+		writeJSON(w, http.StatusConflict, map[string]any{
+			"error":     "batch rolled back",
+			"results":   results,
+			"succeeded": 0,
+			"failed":    failed,
+		})
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"results":   results,
+		"succeeded": len(results) - failed,
+		"failed":    failed,
+	})
+}
+
+// runBatchOp: tek işlemi savepoint içinde uygular; hata olursa sadece o işlem geri alınır.
+// Hep-ya-hiç modda da savepoint kullanılır ki kalan işlemlerin sonuçları raporlanabilsin.
+func (h *ApplicationsHandler) runBatchOp(ctx context.Context, tx pgx.Tx, uid int64, op BatchOp) (repo.Application, bool, error) {
+	sp, err := tx.Begin(ctx)
+	if err != nil {
+		return repo.Application{}, false, err
+	}
+	defer func() { _ = sp.Rollback(ctx) }()
+	q := h.q.WithTx(sp)
+
+	app, changed, err := applyBatchOp(ctx, q, uid, op)
+	if err != nil {
+		return app, false, err
+	}
+	return app, changed, sp.Commit(ctx)
+}
+
+func applyBatchOp(ctx context.Context, q *repo.Queries, uid int64, op BatchOp) (repo.Application, bool, error) {
+	if op.ID <= 0 {
+		return repo.Application{}, false, &batchOpError{Code: http.StatusBadRequest, Msg: "invalid id"}
+	}
+	// tekil endpoint'lerdeki gibi: satırı sahiplik koşuluyla kilitle
+	cur, err := q.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{ID: op.ID, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			return cur, false, &batchOpError{Code: http.StatusNotFound, Msg: "application not found"}
+		}
+		return cur, false, err
+	}
+
+	switch op.Op {
+	case "status":
+		return batchChangeStatus(ctx, q, uid, cur, op)
+	case "delete":
+		if _, err := q.SoftDeleteApplication(ctx, repo.SoftDeleteApplicationParams{ID: cur.ID, UserID: uid}); err != nil {
+			return cur, false, err
+		}
+		err := recordEvent(ctx, q, uid, &cur.ID, eventApplicationDeleted, map[string]any{
+			"application_id": cur.ID,
+			"batch":          true,
+		})
+		return repo.Application{}, true, err
+	case "set_next_action_at":
+		return batchSetNextActionAt(ctx, q, uid, cur, op)
+	case "add_label", "remove_label":
+		if op.LabelID <= 0 {
+			return cur, false, &batchOpError{Code: http.StatusBadRequest, Msg: "label_id required"}
+		}
+		l, err := q.GetLabel(ctx, repo.GetLabelParams{ID: op.LabelID, UserID: uid})
+		if err != nil {
+			if isNoRows(err) {
+				return cur, false, &batchOpError{Code: http.StatusNotFound, Msg: "label not found"}
+			}
+			return cur, false, err
+		}
+		changed, err := setApplicationLabel(ctx, q, uid, cur.ID, l, op.Op == "add_label")
+		return cur, changed, err
+	default:
+		return cur, false, &batchOpError{Code: http.StatusBadRequest, Msg: "unknown op: " + op.Op}
+	}
+}
+
+// batchChangeStatus: PATCH /{id}:status ile aynı kurallar (pipeline geçişi, board'da kolon başı)
+func batchChangeStatus(ctx context.Context, q *repo.Queries, uid int64, cur repo.Application, op BatchOp) (repo.Application, bool, error) {
+	if op.Status == "" {
+		return cur, false, &batchOpError{Code: http.StatusBadRequest, Msg: "invalid status"}
+	}
+	if cur.Status == op.Status {
+		return cur, false, nil
+	}
+	if err := validateTransition(ctx, q, cur.Status, op.Status); err != nil {
+		var te *transitionError
+		switch {
+		case errors.Is(err, errUnknownStatus):
+			return cur, false, &batchOpError{Code: http.StatusBadRequest, Msg: err.Error()}
+		case errors.As(err, &te):
+			return cur, false, &batchOpError{Code: http.StatusConflict, Msg: te.Error(), AllowedNext: te.AllowedNext}
+		}
+		return cur, false, err
+	}
+
+	boardRank, err := topBoardRank(ctx, q, uid, op.Status)
+	if err != nil {
+		return cur, false, err
+	}
+	app, err := q.UpdateApplicationStatus(ctx, repo.UpdateApplicationStatusParams{
+		ID:        cur.ID,
+		UserID:    uid,
+		Status:    op.Status,
+		BoardRank: boardRank,
+	})
+	if err != nil {
+		return cur, false, err
+	}
+	payload := map[string]any{
+		"application_id": app.ID,
+		"user_id":        uid,
+		"old_status":     cur.Status,
+		"new_status":     app.Status,
+		"updated_at":     app.UpdatedAt,
+		"batch":          true,
+	}
+	if op.Note != nil && *op.Note != "" {
+		payload["note"] = *op.Note
+	}
+	return app, true, recordEvent(ctx, q, uid, &app.ID, eventApplicationStatusChanged, payload)
+}
+
+func batchSetNextActionAt(ctx context.Context, q *repo.Queries, uid int64, cur repo.Application, op BatchOp) (repo.Application, bool, error) {
+	var at *time.Time
+	if op.NextActionAt != nil {
+		t, err := time.Parse(time.RFC3339, *op.NextActionAt)
+		if err != nil {
+			return cur, false, &batchOpError{Code: http.StatusBadRequest, Msg: "invalid next_action_at (RFC3339)"}
+		}
+		at = &t
+	}
+	if (at == nil && cur.NextActionAt == nil) || (at != nil && cur.NextActionAt != nil && at.Equal(*cur.NextActionAt)) {
+		return cur, false, nil
+	}
+
+	app, err := q.UpdateApplication(ctx, repo.UpdateApplicationParams{
+		SetNextActionAt: true,
+		NextActionAt:    at,
+		ID:              cur.ID,
+		UserID:          uid,
+	})
+	if err != nil {
+		return cur, false, err
+	}
+	return app, true, recordEvent(ctx, q, uid, &app.ID, eventApplicationUpdated, map[string]any{
+		"application_id":     app.ID,
+		"fields":             []string{"next_action_at"},
+		"old_next_action_at": cur.NextActionAt,
+		"next_action_at":     app.NextActionAt,
+		"batch":              true,
+	})
+}
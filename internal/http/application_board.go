@@ -0,0 +1,249 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Ali0NAL/talentpass/internal/rank"
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+// topBoardRank: kolonun en üstüne konacak kart için rank. q transaction'lı olmalı:
+// kolon kilidi, rank yazılıp commit edilene kadar tutulur.
+func topBoardRank(ctx context.Context, q *repo.Queries, uid int64, status string) (string, error) {
+	if err := q.LockBoardColumn(ctx, repo.LockBoardColumnParams{UserID: uid, Status: status}); err != nil {
+		return "", err
+	}
+	top, err := q.GetBoardTopRank(ctx, repo.GetBoardTopRankParams{UserID: uid, Status: status})
+	if err != nil {
+		return "", err
+	}
+	if top == "" {
+		return rank.First(), nil
+	}
+	return rank.Between("", top)
+}
+
+type MoveReq struct {
+	Status   string `json:"status"`              // hedef kolon; boşsa mevcut status
+	AfterID  *int64 `json:"after_id,omitempty"`  // bu kartın hemen altına
+	BeforeID *int64 `json:"before_id,omitempty"` // bu kartın hemen üstüne
+}
+
+// @Summary      Move application on board
+// @Description  Status ve kolon içi sırayı tek transaction'da değiştirir. after_id ve/veya before_id
+// @Description  hedef kolondaki komşu kartlardır; ikisi de yoksa kart kolonun en üstüne konur.
+// @Description  Status değişiyorsa pipeline geçiş kuralı uygulanır (409 + geçerli sonraki aşamalar).
+// @Description  Yanıtta kartın yeni komşuları (prev / next) döner.
+// @Tags         applications
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int64    true  "application id"
+// @Param        body  body      MoveReq  true  "move payload"
+// @Success      200   {object}  map[string]any
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Failure      409   {object}  map[string]any
+// @Router       /v1/applications/{id}:move [post]
+func (h *ApplicationsHandler) move(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || appID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req MoveReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	if (req.AfterID != nil && *req.AfterID == appID) || (req.BeforeID != nil && *req.BeforeID == appID) {
+		writeError(w, http.StatusBadRequest, "after_id / before_id must be another application")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	cur, err := qtx.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{ID: appID, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	status := req.Status
+	if status == "" {
+		status = cur.Status
+	}
+	if status != cur.Status {
+		if err := validateTransition(ctx, qtx, cur.Status, status); err != nil {
+			writeTransitionError(w, err)
+			return
+		}
+	}
+	// aynı kolona eşzamanlı taşıma / ekleme aynı aralıktan aynı rank'i üretmesin
+	if err := qtx.LockBoardColumn(ctx, repo.LockBoardColumnParams{UserID: uid, Status: status}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	// komşular kilitlenir: aynı aralığa eşzamanlı taşımalar sıralanır
+	neighbour := func(id *int64, name string) (*repo.Application, bool) {
+		if id == nil {
+			return nil, true
+		}
+		n, err := qtx.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{ID: *id, UserID: uid})
+		if err != nil {
+			if isNoRows(err) {
+				writeError(w, http.StatusNotFound, name+" application not found")
+				return nil, false
+			}
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return nil, false
+		}
+		if n.Status != status {
+			writeError(w, http.StatusBadRequest, name+" application is not in status "+status)
+			return nil, false
+		}
+		return &n, true
+	}
+	after, ok := neighbour(req.AfterID, "after_id")
+	if !ok {
+		return
+	}
+	before, ok := neighbour(req.BeforeID, "before_id")
+	if !ok {
+		return
+	}
+
+	// verilmeyen komşu kolondan tamamlanır
+	var lo, hi string
+	switch {
+	case after != nil && before != nil:
+		lo, hi = after.BoardRank, before.BoardRank
+	case after != nil:
+		lo = after.BoardRank
+		next, err := qtx.GetBoardNext(ctx, repo.GetBoardNextParams{
+			UserID: uid, Status: status, ExcludeID: appID, BoardRank: after.BoardRank, ID: after.ID,
+		})
+		switch {
+		case err == nil:
+			hi = next.BoardRank
+		case !isNoRows(err):
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	case before != nil:
+		hi = before.BoardRank
+		prev, err := qtx.GetBoardPrev(ctx, repo.GetBoardPrevParams{
+			UserID: uid, Status: status, ExcludeID: appID, BoardRank: before.BoardRank, ID: before.ID,
+		})
+		switch {
+		case err == nil:
+			lo = prev.BoardRank
+		case !isNoRows(err):
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	default:
+		// kolonun en üstü: kendisi hariç ilk kartın önü
+		first, err := qtx.GetBoardNext(ctx, repo.GetBoardNextParams{
+			UserID: uid, Status: status, ExcludeID: appID, BoardRank: "", ID: 0,
+		})
+		switch {
+		case err == nil:
+			hi = first.BoardRank
+		case !isNoRows(err):
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+
+	newRank, err := rank.Between(lo, hi)
+	if errors.Is(err, rank.ErrInvalidRange) {
+		// after/before sırası ters (rank'ler kolonda unique): istemci board'u yenilemeli
+		writeError(w, http.StatusConflict, "neighbours are out of order, reload the board")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "rank error: "+err.Error())
+		return
+	}
+
+	app, err := qtx.MoveApplication(ctx, repo.MoveApplicationParams{
+		Status:    status,
+		BoardRank: newRank,
+		ID:        appID,
+		UserID:    uid,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if status != cur.Status {
+		if err := recordEvent(ctx, qtx, uid, &appID, eventApplicationStatusChanged, map[string]any{
+			"application_id": app.ID,
+			"user_id":        uid,
+			"old_status":     cur.Status,
+			"new_status":     app.Status,
+			"updated_at":     app.UpdatedAt,
+		}); err != nil {
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+
+	// istemcinin uzlaştırması için gerçek komşular
+	var prev, next *repo.Application
+	if p, err := qtx.GetBoardPrev(ctx, repo.GetBoardPrevParams{
+		UserID: uid, Status: status, ExcludeID: appID, BoardRank: app.BoardRank, ID: app.ID,
+	}); err == nil {
+		prev = &p
+	} else if !isNoRows(err) {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n, err := qtx.GetBoardNext(ctx, repo.GetBoardNextParams{
+		UserID: uid, Status: status, ExcludeID: appID, BoardRank: app.BoardRank, ID: app.ID,
+	}); err == nil {
+		next = &n
+	} else if !isNoRows(err) {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"application": app,
+		"prev":        prev,
+		"next":        next,
+	})
+}
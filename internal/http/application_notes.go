@@ -0,0 +1,421 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+// not gövdesi üst sınırı (markdown)
+const maxNoteBodyLen = 20000
+
+type CreateNoteReq struct {
+	Body   string `json:"body"`   // markdown
+	Pinned bool   `json:"pinned"` // optional
+}
+
+type UpdateNoteReq struct {
+	Body   *string `json:"body"`
+	Pinned *bool   `json:"pinned"`
+}
+
+func validateNoteBody(body string) (string, bool) {
+	body = strings.TrimSpace(body)
+	return body, body != "" && len(body) <= maxNoteBodyLen
+}
+
+// loadNoteApplication: başvurunun kullanıcıya ait olduğunu doğrular; değilse 404 yazar.
+// Transaction'lı q ile çağrıldığında başvuru satırı kilitlenir (not + event birlikte yazılır).
+func loadNoteApplication(ctx context.Context, w http.ResponseWriter, q *repo.Queries, appID, uid int64, lock bool) bool {
+	var err error
+	if lock {
+		_, err = q.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{ID: appID, UserID: uid})
+	} else {
+		_, err = q.GetApplicationByID(ctx, repo.GetApplicationByIDParams{ID: appID, UserID: uid})
+	}
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return false
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// notePathIDs: {id} ve {noteId} path parametreleri
+func notePathIDs(w http.ResponseWriter, r *http.Request) (appID, noteID int64, ok bool) {
+	appID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || appID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return 0, 0, false
+	}
+	if chi.URLParam(r, "noteId") == "" {
+		return appID, 0, true
+	}
+	noteID, err = strconv.ParseInt(chi.URLParam(r, "noteId"), 10, 64)
+	if err != nil || noteID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid note id")
+		return 0, 0, false
+	}
+	return appID, noteID, true
+}
+
+// @Summary      Add note
+// @Tags         notes
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int64          true  "application id"
+// @Param        body  body  CreateNoteReq  true  "note payload"
+// @Success      201   {object}  repo.ApplicationNote
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Router       /v1/applications/{id}/notes [post]
+func (h *ApplicationsHandler) createNote(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, _, ok := notePathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateNoteReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	body, ok := validateNoteBody(req.Body)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "body required (max 20000 chars)")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	if !loadNoteApplication(ctx, w, qtx, appID, uid, true) {
+		return
+	}
+	note, err := qtx.CreateApplicationNote(ctx, repo.CreateApplicationNoteParams{
+		ApplicationID: appID,
+		AuthorID:      &uid,
+		Body:          body,
+		Pinned:        req.Pinned,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	// not da başvuru aktivitesidir: ghosting son aktiviteyi event'lerden de okur
+	if err := recordEvent(ctx, qtx, uid, &appID, eventNoteCreated, map[string]any{
+		"application_id": appID,
+		"note_id":        note.ID,
+		"pinned":         note.Pinned,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, note)
+}
+
+// @Summary      List notes
+// @Description  Sabitlenen notlar önce, sonra kronolojik sırada
+// @Tags         notes
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  int64  true  "application id"
+// @Success      200  {object}  map[string]any
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/notes [get]
+func (h *ApplicationsHandler) listNotes(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, _, ok := notePathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !loadNoteApplication(ctx, w, h.q, appID, uid, false) {
+		return
+	}
+	notes, err := h.q.ListApplicationNotes(ctx, appID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if notes == nil {
+		notes = []repo.ApplicationNote{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": notes})
+}
+
+// @Summary      Update note
+// @Description  body değişirse edited_at güncellenir; pinned ile sabitleme. Sadece notun yazarı düzenleyebilir.
+// @Tags         notes
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id      path  int64          true  "application id"
+// @Param        noteId  path  int64          true  "note id"
+// @Param        body    body  UpdateNoteReq  true  "note patch"
+// @Success      200     {object}  repo.ApplicationNote
+// @Failure      400     {object}  map[string]string
+// @Failure      403     {object}  map[string]string
+// @Failure      404     {object}  map[string]string
+// @Router       /v1/applications/{id}/notes/{noteId} [patch]
+func (h *ApplicationsHandler) updateNote(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, noteID, ok := notePathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateNoteReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	if req.Body == nil && req.Pinned == nil {
+		writeError(w, http.StatusBadRequest, "body or pinned required")
+		return
+	}
+	if req.Body != nil {
+		body, ok := validateNoteBody(*req.Body)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "body required (max 20000 chars)")
+			return
+		}
+		req.Body = &body
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	if !loadNoteApplication(ctx, w, qtx, appID, uid, true) {
+		return
+	}
+	if !checkNoteAuthor(ctx, w, qtx, appID, noteID, uid) {
+		return
+	}
+	note, err := qtx.UpdateApplicationNote(ctx, repo.UpdateApplicationNoteParams{
+		Body:          req.Body,
+		Pinned:        req.Pinned,
+		ID:            noteID,
+		ApplicationID: appID,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "note not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	fields := []string{}
+	if req.Body != nil {
+		fields = append(fields, "body")
+	}
+	if req.Pinned != nil {
+		fields = append(fields, "pinned")
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventNoteUpdated, map[string]any{
+		"application_id": appID,
+		"note_id":        note.ID,
+		"fields":         fields,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, note)
+}
+
+// @Summary      Delete note
+// @Tags         notes
+// @Security     BearerAuth
+// @Param        id      path  int64  true  "application id"
+// @Param        noteId  path  int64  true  "note id"
+// @Success      204     "No Content"
+// @Failure      403     {object}  map[string]string
+// @Failure      404     {object}  map[string]string
+// @Router       /v1/applications/{id}/notes/{noteId} [delete]
+func (h *ApplicationsHandler) deleteNote(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, noteID, ok := notePathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	if !loadNoteApplication(ctx, w, qtx, appID, uid, true) {
+		return
+	}
+	if !checkNoteAuthor(ctx, w, qtx, appID, noteID, uid) {
+		return
+	}
+	n, err := qtx.DeleteApplicationNote(ctx, repo.DeleteApplicationNoteParams{ID: noteID, ApplicationID: appID})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 {
+		writeError(w, http.StatusNotFound, "note not found")
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventNoteDeleted, map[string]any{
+		"application_id": appID,
+		"note_id":        noteID,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkNoteAuthor: not yoksa 404, başkasına aitse 403 yazar.
+func checkNoteAuthor(ctx context.Context, w http.ResponseWriter, q *repo.Queries, appID, noteID, uid int64) bool {
+	note, err := q.GetApplicationNote(ctx, repo.GetApplicationNoteParams{ID: noteID, ApplicationID: appID})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "note not found")
+			return false
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return false
+	}
+	if note.AuthorID == nil || *note.AuthorID != uid {
+		writeError(w, http.StatusForbidden, "only the author can modify this note")
+		return false
+	}
+	return true
+}
+
+// @Summary      Search notes
+// @Description  Kullanıcının tüm başvurularındaki notlarda full-text arama (websearch sözdizimi: "tam ifade", -hariç, or)
+// @Tags         notes
+// @Security     BearerAuth
+// @Produce      json
+// @Param        q       query  string  true   "arama ifadesi"
+// @Param        limit   query  int     false  "limit (1-100)"
+// @Param        offset  query  int     false  "offset"
+// @Success      200     {object}  map[string]any
+// @Failure      400     {object}  map[string]string
+// @Router       /v1/applications/notes:search [get]
+func (h *ApplicationsHandler) searchNotes(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	q := r.URL.Query()
+	query := strings.TrimSpace(q.Get("q"))
+	if query == "" {
+		writeError(w, http.StatusBadRequest, "q required")
+		return
+	}
+	limit := int32(20)
+	if s := q.Get("limit"); s != "" {
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v > 0 && v <= 100 {
+			limit = int32(v)
+		}
+	}
+	offset := int32(0)
+	if s := q.Get("offset"); s != "" {
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v >= 0 {
+			offset = int32(v)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	rows, err := h.q.SearchApplicationNotes(ctx, repo.SearchApplicationNotesParams{
+		Query:  query,
+		UserID: uid,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	type hit struct {
+		repo.ApplicationNote
+		Snippet string  `json:"snippet"`
+		Rank    float64 `json:"rank"`
+	}
+	items := make([]hit, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, hit{ApplicationNote: row.ApplicationNote, Snippet: row.Snippet, Rank: row.Rank})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"items":  items,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
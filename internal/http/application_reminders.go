@@ -0,0 +1,139 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+type SnoozeReminderReq struct {
+	Until *string `json:"until"` // RFC3339; ya until ya for
+	For   *string `json:"for"`   // Go duration, örn. "24h", "90m"
+}
+
+// @Summary      Snooze reminder
+// @Description  next_action_at'i ileri alır; yeni tarih geldiğinde hatırlatma tekrar gönderilir
+// @Tags         applications
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int64              true  "application id"
+// @Param        body  body  SnoozeReminderReq  true  "until veya for"
+// @Success      200   {object}  repo.Application
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Router       /v1/applications/{id}/reminder:snooze [post]
+func (h *ApplicationsHandler) snoozeReminder(w http.ResponseWriter, r *http.Request) {
+	var req SnoozeReminderReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+
+	now := time.Now()
+	var until time.Time
+	switch {
+	case req.Until != nil && req.For == nil:
+		t, err := time.Parse(time.RFC3339, *req.Until)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid until (RFC3339)")
+			return
+		}
+		until = t
+	case req.For != nil && req.Until == nil:
+		d, err := time.ParseDuration(*req.For)
+		if err != nil || d <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid for (duration, e.g. 24h)")
+			return
+		}
+		until = now.Add(d)
+	default:
+		writeError(w, http.StatusBadRequest, "exactly one of until or for required")
+		return
+	}
+	if !until.After(now) {
+		writeError(w, http.StatusBadRequest, "until must be in the future")
+		return
+	}
+
+	h.setNextAction(w, r, &until, eventApplicationReminderSnoozed)
+}
+
+// @Summary      Complete reminder
+// @Description  Aksiyon yapıldı: next_action_at temizlenir, başka hatırlatma gönderilmez
+// @Tags         applications
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  int64  true  "application id"
+// @Success      200  {object}  repo.Application
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/reminder:complete [post]
+func (h *ApplicationsHandler) completeReminder(w http.ResponseWriter, r *http.Request) {
+	h.setNextAction(w, r, nil, eventApplicationReminderCompleted)
+}
+
+// setNextAction: next_action_at'i günceller ve eski/yeni değerle event yazar.
+func (h *ApplicationsHandler) setNextAction(w http.ResponseWriter, r *http.Request, next *time.Time, eventType string) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	appID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || appID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	cur, err := qtx.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{ID: appID, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	app, err := qtx.UpdateApplication(ctx, repo.UpdateApplicationParams{
+		SetNextActionAt: true,
+		NextActionAt:    next,
+		ID:              appID,
+		UserID:          uid,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventType, map[string]any{
+		"application_id":     appID,
+		"old_next_action_at": cur.NextActionAt,
+		"next_action_at":     app.NextActionAt,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, app)
+}
@@ -0,0 +1,169 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+type stageView struct {
+	repo.ApplicationStage
+	Next []string `json:"next"` // izin verilen sonraki aşamalar
+}
+
+// @Summary      List application stages
+// @Description  Başvuru pipeline'ı: aşamalar ve her aşamadan izin verilen geçişler
+// @Tags         applications
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  map[string]any
+// @Router       /v1/applications/stages [get]
+func (h *ApplicationsHandler) listStages(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	stages, err := h.q.ListApplicationStages(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	transitions, err := h.q.ListApplicationTransitions(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	next := map[string][]string{}
+	for _, t := range transitions {
+		next[t.FromStatus] = append(next[t.FromStatus], t.ToStatus)
+	}
+	out := make([]stageView, 0, len(stages))
+	for _, s := range stages {
+		n := next[s.Status]
+		if n == nil {
+			n = []string{}
+		}
+		out = append(out, stageView{ApplicationStage: s, Next: n})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"stages": out})
+}
+
+// validateInitialStatus: başvuru oluştururken verilen status'un başlangıç aşaması
+// olduğunu kontrol eder; değilse 400 yazar.
+func validateInitialStatus(ctx context.Context, w http.ResponseWriter, q *repo.Queries, status string) bool {
+	stages, err := q.ListApplicationStages(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return false
+	}
+	var initial []string
+	for _, s := range stages {
+		if !s.IsInitial {
+			continue
+		}
+		if s.Status == status {
+			return true
+		}
+		initial = append(initial, s.Status)
+	}
+	writeError(w, http.StatusBadRequest, "invalid status, must be one of: "+strings.Join(initial, ", "))
+	return false
+}
+
+// errUnknownStatus: hedef status pipeline'da tanımlı değil (400)
+var errUnknownStatus = errors.New("invalid status")
+
+// transitionError: pipeline'ın izin vermediği geçiş (409); AllowedNext mevcut
+// aşamadan geçilebilecek aşamalar.
+type transitionError struct {
+	From, To    string
+	AllowedNext []string
+}
+
+func (e *transitionError) Error() string {
+	return "invalid status transition: " + e.From + " -> " + e.To
+}
+
+// validateTransition: from -> to geçişine izin var mı? Bilinmeyen status için
+// errUnknownStatus, izinsiz geçiş için *transitionError, DB hatasında hatanın kendisi.
+func validateTransition(ctx context.Context, q *repo.Queries, from, to string) error {
+	next, err := q.ListNextStatuses(ctx, from)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(next, to) {
+		return nil
+	}
+
+	stages, err := q.ListApplicationStages(ctx)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(stages, func(s repo.ApplicationStage) bool { return s.Status == to }) {
+		return errUnknownStatus
+	}
+	if next == nil {
+		next = []string{}
+	}
+	return &transitionError{From: from, To: to, AllowedNext: next}
+}
+
+// writeTransitionError: validateTransition hatasını tekil endpoint yanıtına çevirir
+// (400 / geçerli sonraki aşamaları listeleyen 409 / 500).
+func writeTransitionError(w http.ResponseWriter, err error) {
+	var te *transitionError
+	switch {
+	case errors.Is(err, errUnknownStatus):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.As(err, &te):
+		writeJSON(w, http.StatusConflict, map[string]any{
+			"error":          te.Error(),
+			"current_status": te.From,
+			"allowed_next":   te.AllowedNext,
+		})
+	default:
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+	}
+}
+
+// autoAdvanceStatus: yan kayıt (mülakat, teklif) eklenince başvuruyu target aşamasına
+// taşır; zaten oradaysa veya pipeline geçişe izin vermiyorsa hiçbir şey yapmaz.
+// Geçiş timeline'da görünsün diye status.changed event'i yazılır. app kilitli olmalı.
+func autoAdvanceStatus(ctx context.Context, q *repo.Queries, uid int64, app repo.Application, target, note string) error {
+	if app.Status == target {
+		return nil
+	}
+	next, err := q.ListNextStatuses(ctx, app.Status)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(next, target) {
+		return nil
+	}
+	boardRank, err := topBoardRank(ctx, q, uid, target)
+	if err != nil {
+		return err
+	}
+	updated, err := q.UpdateApplicationStatus(ctx, repo.UpdateApplicationStatusParams{
+		Status:    target,
+		BoardRank: boardRank,
+		ID:        app.ID,
+		UserID:    uid,
+	})
+	if err != nil {
+		return err
+	}
+	return recordEvent(ctx, q, uid, &app.ID, eventApplicationStatusChanged, map[string]any{
+		"application_id": app.ID,
+		"user_id":        uid,
+		"old_status":     app.Status,
+		"new_status":     updated.Status,
+		"updated_at":     updated.UpdatedAt,
+		"note":           note,
+	})
+}
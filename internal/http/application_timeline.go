@@ -0,0 +1,128 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+type timelineEntry struct {
+	Status          string     `json:"status"`
+	FromStatus      *string    `json:"from_status"`
+	EnteredAt       time.Time  `json:"entered_at"`
+	ExitedAt        *time.Time `json:"exited_at"`        // mevcut aşamada null
+	DurationSeconds int64      `json:"duration_seconds"` // mevcut aşama için şu ana kadar
+	Note            *string    `json:"note,omitempty"`
+}
+
+// statusEventPayload: application.created / application.status.changed payload'ları
+type statusEventPayload struct {
+	Status    string  `json:"status"`
+	OldStatus string  `json:"old_status"`
+	NewStatus string  `json:"new_status"`
+	Note      *string `json:"note"`
+}
+
+// @Summary      Application timeline
+// @Description  Status geçişleri: her aşamaya giriş/çıkış zamanı, aşamada geçen süre ve notlar
+// @Tags         applications
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  int64  true  "application id"
+// @Success      200  {object}  map[string]any
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/timeline [get]
+func (h *ApplicationsHandler) timeline(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	appID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || appID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	app, err := h.q.GetApplicationByID(ctx, repo.GetApplicationByIDParams{ID: appID, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	events, err := h.q.ListApplicationEventsByType(ctx, repo.ListApplicationEventsByTypeParams{
+		ApplicationID: appID,
+		Types:         []string{eventApplicationCreated, eventApplicationStatusChanged},
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	entries := buildTimeline(app, events, time.Now())
+	writeJSON(w, http.StatusOK, map[string]any{
+		"application_id": app.ID,
+		"current_status": app.Status,
+		"items":          entries,
+	})
+}
+
+// buildTimeline: event'lerden aşama listesi çıkarır. Eski başvurularda
+// application.created event'i yoksa başlangıç aşaması ilk geçişin old_status'undan
+// ve created_at'ten türetilir.
+func buildTimeline(app repo.Application, events []repo.Event, now time.Time) []timelineEntry {
+	entries := []timelineEntry{}
+	push := func(status string, from *string, at time.Time, note *string) {
+		if n := len(entries); n > 0 {
+			prev := &entries[n-1]
+			exited := at
+			prev.ExitedAt = &exited
+			prev.DurationSeconds = int64(at.Sub(prev.EnteredAt).Seconds())
+		}
+		entries = append(entries, timelineEntry{Status: status, FromStatus: from, EnteredAt: at, Note: note})
+	}
+
+	for _, ev := range events {
+		var p statusEventPayload
+		if err := json.Unmarshal(ev.PayloadJson, &p); err != nil {
+			continue
+		}
+		switch ev.Type {
+		case eventApplicationCreated:
+			if len(entries) == 0 {
+				push(p.Status, nil, ev.CreatedAt, nil)
+			}
+		case eventApplicationStatusChanged:
+			if len(entries) == 0 {
+				initial := p.OldStatus
+				if initial == "" {
+					initial = "applied" // eski payload'larda old_status yok; kolon default'u
+				}
+				push(initial, nil, app.CreatedAt, nil)
+			}
+			from := entries[len(entries)-1].Status
+			push(p.NewStatus, &from, ev.CreatedAt, p.Note)
+		}
+	}
+	if len(entries) == 0 {
+		push(app.Status, nil, app.CreatedAt, nil)
+	}
+
+	last := &entries[len(entries)-1]
+	last.DurationSeconds = int64(now.Sub(last.EnteredAt).Seconds())
+	return entries
+}
@@ -3,8 +3,10 @@ package httpx
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -25,29 +27,56 @@ func NewApplicationsHandler(pool *pgxpool.Pool) *ApplicationsHandler {
 
 func (h *ApplicationsHandler) Router() http.Handler {
 	r := chi.NewRouter()
-	r.Post("/", h.create)                   // POST   /v1/applications
-	r.Get("/", h.list)                      // GET    /v1/applications
-	r.Patch("/{id}:status", h.updateStatus) // PATCH  /v1/applications/{id}:status
+	r.Post("/", h.create)                                 // POST   /v1/applications
+	r.Get("/", h.list)                                    // GET    /v1/applications
+	r.Get("/notes:search", h.searchNotes)                 // GET    /v1/applications/notes:search
+	r.Get("/stages", h.listStages)                        // GET    /v1/applications/stages
+	r.Patch("/{id}:status", h.updateStatus)               // PATCH  /v1/applications/{id}:status
+	r.Post("/{id}:move", h.move)                          // POST   /v1/applications/{id}:move
+	r.Get("/{id}/timeline", h.timeline)                   // GET    /v1/applications/{id}/timeline
+	r.Post("/{id}/reminder:snooze", h.snoozeReminder)     // POST   /v1/applications/{id}/reminder:snooze
+	r.Post("/{id}/reminder:complete", h.completeReminder) // POST   /v1/applications/{id}/reminder:complete
+	r.Route("/{id}/interviews", func(r chi.Router) {
+		r.Post("/", h.createInterview)                // POST   /v1/applications/{id}/interviews
+		r.Get("/", h.listInterviews)                  // GET    /v1/applications/{id}/interviews
+		r.Get("/{interviewId}", h.getInterview)       // GET    /v1/applications/{id}/interviews/{interviewId}
+		r.Patch("/{interviewId}", h.patchInterview)   // PATCH  /v1/applications/{id}/interviews/{interviewId}
+		r.Delete("/{interviewId}", h.deleteInterview) // DELETE /v1/applications/{id}/interviews/{interviewId}
+	})
+	r.Route("/{id}/notes", func(r chi.Router) {
+		r.Post("/", h.createNote)           // POST   /v1/applications/{id}/notes
+		r.Get("/", h.listNotes)             // GET    /v1/applications/{id}/notes
+		r.Patch("/{noteId}", h.updateNote)  // PATCH  /v1/applications/{id}/notes/{noteId}
+		r.Delete("/{noteId}", h.deleteNote) // DELETE /v1/applications/{id}/notes/{noteId}
+	})
+	r.Get("/{id}", h.get)       // GET    /v1/applications/{id}
+	r.Patch("/{id}", h.patch)   // PATCH  /v1/applications/{id}
+	r.Delete("/{id}", h.delete) // DELETE /v1/applications/{id}
 	return r
 }
 
 type CreateAppReq struct {
 	JobID        int64   `json:"job_id"`
-	Status       *string `json:"status"`         // optional: applied/interview/offer/denied
-	Notes        *string `json:"notes"`          // optional
+	Status       *string `json:"status"`         // optional: başlangıç aşaması (saved/applied), default applied
+	Notes        *string `json:"notes"`          // optional: ilk not olarak kaydedilir
 	NextActionAt *string `json:"next_action_at"` // RFC3339 (optional)
 }
 
 // @Summary      Create application
-// @Description  Bir ilana başvuru oluşturur
+// @Description  Bir ilana başvuru oluşturur. Aynı ilana aktif başvuru varsa 409;
+// @Description  upsert=true ile mevcut başvuru 200 olarak döner.
 // @Tags         applications
 // @Security     BearerAuth
 // @Accept       json
 // @Produce      json
-// @Param        body  body  CreateAppReq  true  "application payload"
-// @Success      201   {object}  repo.Application
-// @Failure      400   {object}  map[string]string
-// @Failure      401   {object}  map[string]string
+// @Param        upsert  query  bool          false  "varsa mevcut başvuruyu döndür"
+// @Param        body    body   CreateAppReq  true   "application payload"
+// @Success      200     {object}  repo.Application
+// @Success      201     {object}  repo.Application
+// @Failure      400     {object}  map[string]string
+// @Failure      401     {object}  map[string]string
+// @Failure      404     {object}  map[string]string
+// @Failure      409     {object}  map[string]any
 // @Router       /v1/applications [post]
 func (h *ApplicationsHandler) create(w http.ResponseWriter, r *http.Request) {
 	uid, ok := UserIDFromContext(r.Context())
@@ -65,6 +94,7 @@ func (h *ApplicationsHandler) create(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, "job_id required")
 		return
 	}
+	upsert := r.URL.Query().Get("upsert") == "true"
 
 	// next_action_at parse (opsiyonel)
 	var nextAt *time.Time
@@ -80,31 +110,130 @@ func (h *ApplicationsHandler) create(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
 	defer cancel()
 
-	app, err := h.q.CreateApplication(ctx, repo.CreateApplicationParams{
+	// status sadece başlangıç aşamalarından biri olabilir
+	if req.Status != nil && !validateInitialStatus(ctx, w, h.q, *req.Status) {
+		return
+	}
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	// yeni kart kolonunun en üstüne
+	status := "applied"
+	if req.Status != nil {
+		status = *req.Status
+	}
+	boardRank, err := topBoardRank(ctx, qtx, uid, status)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	app, err := qtx.CreateApplication(ctx, repo.CreateApplicationParams{
 		JobID:        req.JobID,
 		UserID:       uid,
 		Status:       req.Status,
-		Notes:        req.Notes,
 		NextActionAt: nextAt,
+		BoardRank:    boardRank,
 	})
 	if err != nil {
+		switch {
+		case isForeignKeyViolation(err):
+			writeError(w, http.StatusNotFound, "job not found")
+		case isUniqueViolation(err):
+			h.writeExistingApplication(ctx, w, uid, req.JobID, upsert)
+		default:
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		}
+		return
+	}
+
+	// notes verilmişse ilk not olarak yazılır
+	if req.Notes != nil && strings.TrimSpace(*req.Notes) != "" {
+		if _, err := qtx.CreateApplicationNote(ctx, repo.CreateApplicationNoteParams{
+			ApplicationID: app.ID,
+			AuthorID:      &uid,
+			Body:          *req.Notes,
+		}); err != nil {
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+
+	// timeline'ın başlangıç noktası
+	if err := recordEvent(ctx, qtx, uid, &app.ID, eventApplicationCreated, map[string]any{
+		"application_id": app.ID,
+		"job_id":         app.JobID,
+		"status":         app.Status,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
 		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
 		return
 	}
 	writeJSON(w, http.StatusCreated, app)
 }
 
+// writeExistingApplication: (user_id, job_id) unique ihlalinde mevcut başvuruyu
+// upsert modunda 200, değilse 409 ile döner. Transaction iptal olduğu için pool üzerinden okunur.
+func (h *ApplicationsHandler) writeExistingApplication(ctx context.Context, w http.ResponseWriter, uid, jobID int64, upsert bool) {
+	existing, err := h.q.GetApplicationByUserJob(ctx, repo.GetApplicationByUserJobParams{
+		UserID: uid,
+		JobID:  jobID,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if upsert {
+		writeJSON(w, http.StatusOK, existing)
+		return
+	}
+	writeJSON(w, http.StatusConflict, map[string]any{
+		"error":       "application already exists for this job",
+		"application": existing,
+	})
+}
+
+// applicationView: list yanıtı; expand=job ise ilan gömülü gelir
+type applicationView struct {
+	repo.Application
+	Job    *repo.Job    `json:"job,omitempty"`
+	Labels []repo.Label `json:"labels"`
+}
+
+var applicationSorts = map[string]bool{"created_at": true, "updated_at": true, "next_action_at": true, "company": true, "board": true}
+
 // @Summary      List my applications
-// @Description  Kullanıcının kendi başvurularını listeler
+// @Description  Kullanıcının kendi başvurularını listeler. expand=job ile ilan bilgisi aynı sorguda gelir.
 // @Tags         applications
 // @Security     BearerAuth
 // @Accept       json
 // @Produce      json
-// @Param        status  query   string  false  "applied|interview|offer|denied"
-// @Param        limit   query   int     false  "limit (1-100)"
-// @Param        offset  query   int     false  "offset"
-// @Success      200     {object}  map[string]any
-// @Failure      401     {object}  map[string]string
+// @Param        status              query   string  false  "aşama; virgülle birden fazla (bkz. /v1/applications/stages)"
+// @Param        company             query   string  false  "şirket (içerir, case-insensitive)"
+// @Param        tag                 query   string  false  "ilan tag'i"
+// @Param        label_id            query   string  false  "etiket id; virgülle birden fazla (herhangi biri)"
+// @Param        created_after       query   string  false  "RFC3339"
+// @Param        created_before      query   string  false  "RFC3339"
+// @Param        updated_after       query   string  false  "RFC3339"
+// @Param        updated_before      query   string  false  "RFC3339"
+// @Param        next_action_before  query   string  false  "RFC3339; bu tarihten önce aksiyonu olanlar"
+// @Param        sort                query   string  false  "created_at (default) | updated_at | next_action_at | company | board (kanban sırası)"
+// @Param        order               query   string  false  "asc | desc (company için default asc, diğerleri desc)"
+// @Param        expand              query   string  false  "job"
+// @Param        limit               query   int     false  "limit (1-100)"
+// @Param        offset              query   int     false  "offset"
+// @Success      200                 {object}  map[string]any
+// @Failure      400                 {object}  map[string]string
+// @Failure      401                 {object}  map[string]string
 // @Router       /v1/applications [get]
 func (h *ApplicationsHandler) list(w http.ResponseWriter, r *http.Request) {
 	uid, ok := UserIDFromContext(r.Context())
@@ -114,49 +243,122 @@ func (h *ApplicationsHandler) list(w http.ResponseWriter, r *http.Request) {
 	}
 
 	q := r.URL.Query()
-	var status *string
-	if s := q.Get("status"); s != "" {
-		status = &s
+	params := repo.ListApplicationsByUserParams{UserID: uid, Sort: "created_at", Limit: 20}
+
+	// status=applied,interview veya status=applied&status=interview
+	for _, v := range q["status"] {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				params.Statuses = append(params.Statuses, s)
+			}
+		}
+	}
+	if s := q.Get("company"); s != "" {
+		params.Company = &s
+	}
+	if s := q.Get("tag"); s != "" {
+		params.Tag = &s
+	}
+	labelIDs, err := queryIDs(q, "label_id")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	params.LabelIds = labelIDs
+	for name, dst := range map[string]**time.Time{
+		"created_after":      &params.CreatedAfter,
+		"created_before":     &params.CreatedBefore,
+		"updated_after":      &params.UpdatedAfter,
+		"updated_before":     &params.UpdatedBefore,
+		"next_action_before": &params.NextActionBefore,
+	} {
+		s := q.Get(name)
+		if s == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid "+name+" (RFC3339)")
+			return
+		}
+		*dst = &t
+	}
+
+	if s := q.Get("sort"); s != "" {
+		if !applicationSorts[s] {
+			writeError(w, http.StatusBadRequest, "invalid sort, must be one of: created_at, updated_at, next_action_at, company, board")
+			return
+		}
+		params.Sort = s
+	}
+	switch q.Get("order") {
+	case "":
+		params.Asc = params.Sort == "company"
+	case "asc":
+		params.Asc = true
+	case "desc":
+		params.Asc = false
+	default:
+		writeError(w, http.StatusBadRequest, "invalid order, must be asc or desc")
+		return
 	}
-	limit := int32(20)
+	expandJob := q.Get("expand") == "job"
+
 	if s := q.Get("limit"); s != "" {
 		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v > 0 && v <= 100 {
-			limit = int32(v)
+			params.Limit = int32(v)
 		}
 	}
-	offset := int32(0)
 	if s := q.Get("offset"); s != "" {
 		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v >= 0 {
-			offset = int32(v)
+			params.Offset = int32(v)
 		}
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
 	defer cancel()
 
-	rows, err := h.q.ListApplicationsByUser(ctx, repo.ListApplicationsByUserParams{
-		UserID: uid,
-		Status: status,
-		Limit:  limit,
-		Offset: offset,
-	})
+	rows, err := h.q.ListApplicationsByUser(ctx, params)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	appIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		appIDs = append(appIDs, row.Application.ID)
+	}
+	labels, err := applicationLabels(ctx, h.q, uid, appIDs)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
 		return
 	}
+	items := make([]applicationView, 0, len(rows))
+	for _, row := range rows {
+		v := applicationView{Application: row.Application, Labels: labels[row.Application.ID]}
+		if v.Labels == nil {
+			v.Labels = []repo.Label{}
+		}
+		if expandJob {
+			job := row.Job
+			v.Job = &job
+		}
+		items = append(items, v)
+	}
 	writeJSON(w, http.StatusOK, map[string]any{
-		"items":  rows,
-		"limit":  limit,
-		"offset": offset,
+		"items":  items,
+		"limit":  params.Limit,
+		"offset": params.Offset,
 	})
 }
 
 type UpdateStatusReq struct {
-	Status string `json:"status"` // applied/interview/offer/denied
+	Status string  `json:"status"` // hedef aşama (bkz. /v1/applications/stages)
+	Note   *string `json:"note"`   // optional: timeline'da görünür
 }
 
 // @Summary      Update application status
-// @Description  Başvuru durumunu günceller
+// @Description  Başvuru durumunu günceller. Sadece pipeline'da izin verilen geçişler kabul edilir;
+// @Description  aksi halde 409 ve geçerli sonraki aşamalar döner.
 // @Tags         applications
 // @Security     BearerAuth
 // @Accept       json
@@ -166,6 +368,8 @@ type UpdateStatusReq struct {
 // @Success      200   {object}  repo.Application
 // @Failure      400   {object}  map[string]string
 // @Failure      401   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Failure      409   {object}  map[string]any
 // @Router       /v1/applications/{id}:status [patch]
 func (h *ApplicationsHandler) updateStatus(w http.ResponseWriter, r *http.Request) {
 	uid, ok := UserIDFromContext(r.Context())
@@ -186,9 +390,7 @@ func (h *ApplicationsHandler) updateStatus(w http.ResponseWriter, r *http.Reques
 		writeError(w, http.StatusBadRequest, "invalid json")
 		return
 	}
-	switch req.Status {
-	case "applied", "interview", "offer", "denied":
-	default:
+	if req.Status == "" {
 		writeError(w, http.StatusBadRequest, "invalid status")
 		return
 	}
@@ -196,30 +398,347 @@ func (h *ApplicationsHandler) updateStatus(w http.ResponseWriter, r *http.Reques
 	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
 	defer cancel()
 
-	// 1) status güncelle
-	app, err := h.q.UpdateApplicationStatus(ctx, repo.UpdateApplicationStatusParams{
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	// 1) mevcut hali kilitle (sahiplik kontrolü)
+	cur, err := qtx.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{
 		ID:     appID,
-		UserID: uid, // sahiplik kontrolü
-		Status: req.Status,
+		UserID: uid,
 	})
 	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
 		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
 		return
 	}
+	if cur.Status == req.Status {
+		// aynı aşama: değişiklik yok
+		writeJSON(w, http.StatusOK, cur)
+		return
+	}
 
-	// 2) audit event
-	payload, _ := json.Marshal(map[string]any{
+	// 2) geçiş kuralı
+	if err := validateTransition(ctx, qtx, cur.Status, req.Status); err != nil {
+		writeTransitionError(w, err)
+		return
+	}
+
+	// 3) status güncelle; kart yeni kolonun en üstüne
+	boardRank, err := topBoardRank(ctx, qtx, uid, req.Status)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	app, err := qtx.UpdateApplicationStatus(ctx, repo.UpdateApplicationStatusParams{
+		ID:        appID,
+		UserID:    uid, // sahiplik kontrolü
+		Status:    req.Status,
+		BoardRank: boardRank,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	// 4) audit event (status ile aynı transaction'da)
+	payload := map[string]any{
 		"application_id": app.ID,
 		"user_id":        uid,
+		"old_status":     cur.Status,
 		"new_status":     app.Status,
 		"updated_at":     app.UpdatedAt,
+	}
+	if req.Note != nil && *req.Note != "" {
+		payload["note"] = *req.Note
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventApplicationStatusChanged, payload); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, app)
+}
+
+// @Summary      Get application
+// @Tags         applications
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  int64  true  "application id"
+// @Success      200  {object}  repo.Application
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id} [get]
+func (h *ApplicationsHandler) get(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	appID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || appID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	app, err := h.q.GetApplicationByID(ctx, repo.GetApplicationByIDParams{
+		ID:     appID,
+		UserID: uid, // sahiplik kontrolü
 	})
-	_, _ = h.q.CreateEvent(ctx, repo.CreateEventParams{
-		UserID:        &uid,
-		ApplicationID: &appID,
-		Type:          "application.status.changed",
-		PayloadJson:   payload,
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, app)
+}
+
+// @Summary      Patch application
+// @Description  JSON Merge Patch: next_action_at (RFC3339), job_id ve ghosting_opt_out (bool). null, next_action_at'i temizler.
+// @Description  Sadece gerçekten değişen alanlar application.updated event'ine yazılır; değişiklik yoksa event yazılmaz.
+// @Description  Notlar için /v1/applications/{id}/notes kullanılır.
+// @Description  Status değişikliği için /v1/applications/{id}:status kullanılır.
+// @Tags         applications
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int64           true  "application id"
+// @Param        body  body  map[string]any  true  "merge patch"
+// @Success      200   {object}  repo.Application
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Failure      409   {object}  map[string]string
+// @Router       /v1/applications/{id} [patch]
+func (h *ApplicationsHandler) patch(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	appID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || appID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil || patch == nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	params, err := applicationMergePatchParams(appID, uid, patch)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	cur, err := qtx.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{
+		ID:     appID,
+		UserID: uid,
 	})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	// değişmeyen alanlar yazılmaz; hiçbir şey değişmiyorsa update ve event de yok (batch gibi)
+	fields := changedApplicationFields(cur, params)
+	if len(fields) == 0 {
+		writeJSON(w, http.StatusOK, cur)
+		return
+	}
 
+	// başka ilana taşınıyorsa ilan var mı?
+	if params.JobID != nil && *params.JobID != cur.JobID {
+		if _, err := qtx.GetJobByID(ctx, *params.JobID); err != nil {
+			if isNoRows(err) {
+				writeError(w, http.StatusNotFound, "job not found")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+
+	app, err := qtx.UpdateApplication(ctx, params)
+	if err != nil {
+		switch {
+		case isForeignKeyViolation(err):
+			writeError(w, http.StatusNotFound, "job not found")
+		case isUniqueViolation(err):
+			writeError(w, http.StatusConflict, "application already exists for this job")
+		default:
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		}
+		return
+	}
+
+	if err := recordEvent(ctx, qtx, uid, &appID, eventApplicationUpdated, map[string]any{
+		"application_id":     appID,
+		"fields":             fields,
+		"old_job_id":         cur.JobID,
+		"job_id":             app.JobID,
+		"old_next_action_at": cur.NextActionAt,
+		"next_action_at":     app.NextActionAt,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
 	writeJSON(w, http.StatusOK, app)
 }
+
+// applicationMergePatchParams: merge patch dokümanını UpdateApplication parametrelerine çevirir.
+// changedApplicationFields: patch'te olup mevcut değerden farklı olan alanlar (sıralı)
+func changedApplicationFields(cur repo.Application, p repo.UpdateApplicationParams) []string {
+	var fields []string
+	if p.GhostingOptOut != nil && *p.GhostingOptOut != cur.GhostingOptOut {
+		fields = append(fields, "ghosting_opt_out")
+	}
+	if p.JobID != nil && *p.JobID != cur.JobID {
+		fields = append(fields, "job_id")
+	}
+	if p.SetNextActionAt {
+		same := (p.NextActionAt == nil && cur.NextActionAt == nil) ||
+			(p.NextActionAt != nil && cur.NextActionAt != nil && p.NextActionAt.Equal(*cur.NextActionAt))
+		if !same {
+			fields = append(fields, "next_action_at")
+		}
+	}
+	return fields
+}
+
+func applicationMergePatchParams(appID, uid int64, patch map[string]json.RawMessage) (repo.UpdateApplicationParams, error) {
+	params := repo.UpdateApplicationParams{ID: appID, UserID: uid}
+	for field, raw := range patch {
+		null := string(raw) == "null"
+		switch field {
+		case "notes":
+			return params, errors.New("notes are managed via /v1/applications/{id}/notes")
+		case "next_action_at":
+			params.SetNextActionAt = true
+			if null {
+				continue
+			}
+			var v string
+			if json.Unmarshal(raw, &v) != nil {
+				return params, errors.New("invalid next_action_at (RFC3339)")
+			}
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return params, errors.New("invalid next_action_at (RFC3339)")
+			}
+			params.NextActionAt = &t
+		case "job_id":
+			var v int64
+			if null || json.Unmarshal(raw, &v) != nil || v <= 0 {
+				return params, errors.New("job_id must be a positive integer")
+			}
+			params.JobID = &v
+		case "ghosting_opt_out":
+			var v bool
+			if null || json.Unmarshal(raw, &v) != nil {
+				return params, errors.New("ghosting_opt_out must be a boolean")
+			}
+			params.GhostingOptOut = &v
+		default:
+			return params, errors.New("unknown or read-only field: " + field)
+		}
+	}
+	return params, nil
+}
+
+// @Summary      Delete application
+// @Description  Başvuruyu siler (soft delete; event geçmişi korunur)
+// @Tags         applications
+// @Security     BearerAuth
+// @Param        id   path  int64  true  "application id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id} [delete]
+func (h *ApplicationsHandler) delete(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	appID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || appID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	n, err := qtx.SoftDeleteApplication(ctx, repo.SoftDeleteApplicationParams{
+		ID:     appID,
+		UserID: uid, // sahiplik kontrolü
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 {
+		writeError(w, http.StatusNotFound, "application not found")
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventApplicationDeleted, map[string]any{
+		"application_id": appID,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,440 @@
+package httpx
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Ali0NAL/talentpass/internal/auth"
+	"github.com/Ali0NAL/talentpass/internal/repo"
+	"github.com/Ali0NAL/talentpass/internal/storage"
+)
+
+var attachmentKinds = map[string]bool{"resume": true, "cover_letter": true, "other": true}
+
+// kabul edilen içerik tipleri (sniff + uzantı ile belirlenir, istemcinin Content-Type'ına güvenilmez)
+var attachmentMIMEs = map[string]bool{
+	"application/pdf": true,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": true,
+	"application/vnd.oasis.opendocument.text":                                 true,
+	"text/plain":    true,
+	"text/markdown": true,
+}
+
+// AttachmentsHandler: başvuru ekleri (CV, ön yazı). İçerik storage'da SHA-256 key ile
+// tutulur; indirme linkleri imzalı ve süreli.
+type AttachmentsHandler struct {
+	q        *repo.Queries
+	pool     *pgxpool.Pool
+	store    storage.Storage
+	maxBytes int64
+	urlTTL   time.Duration
+}
+
+func NewAttachmentsHandler(pool *pgxpool.Pool, store storage.Storage, maxBytes int64, urlTTL time.Duration) *AttachmentsHandler {
+	return &AttachmentsHandler{q: repo.New(pool), pool: pool, store: store, maxBytes: maxBytes, urlTTL: urlTTL}
+}
+
+// ApplicationRouter: /v1/applications/{id}/attachments altı (auth gerekli)
+func (h *AttachmentsHandler) ApplicationRouter() http.Handler {
+	r := newSubrouter()
+	r.Post("/", h.upload)                 // POST   /v1/applications/{id}/attachments
+	r.Get("/", h.list)                    // GET    /v1/applications/{id}/attachments
+	r.Delete("/{attachmentId}", h.unlink) // DELETE /v1/applications/{id}/attachments/{attachmentId}
+	return r
+}
+
+// Router: /v1/attachments altı; indirme imzalı link ile açılır (auth yok)
+func (h *AttachmentsHandler) Router() http.Handler {
+	r := newSubrouter()
+	r.Get("/{id}/download", h.download) // GET /v1/attachments/{id}/download?expires=&sig=
+	return r
+}
+
+type attachmentView struct {
+	repo.ApplicationAttachment
+	Sha256            string    `json:"sha256"`
+	SizeBytes         int64     `json:"size_bytes"`
+	ContentType       string    `json:"content_type"`
+	DownloadURL       string    `json:"download_url"`
+	DownloadExpiresAt time.Time `json:"download_expires_at"`
+}
+
+func (h *AttachmentsHandler) view(r *http.Request, link repo.ApplicationAttachment, att repo.Attachment) attachmentView {
+	expires := time.Now().Add(h.urlTTL).Truncate(time.Second)
+	path := "/v1/attachments/" + strconv.FormatInt(link.ID, 10) + "/download"
+	q := "?expires=" + strconv.FormatInt(expires.Unix(), 10) + "&sig=" + auth.SignPath(path, expires)
+	return attachmentView{
+		ApplicationAttachment: link,
+		Sha256:                att.Sha256,
+		SizeBytes:             att.SizeBytes,
+		ContentType:           att.ContentType,
+		DownloadURL:           requestBaseURL(r) + path + q,
+		DownloadExpiresAt:     expires,
+	}
+}
+
+// detectAttachmentMIME: ilk 512 bayttan içerik tipi; zip tabanlı ofis formatları uzantıyla ayrılır.
+func detectAttachmentMIME(head []byte, filename string) string {
+	mt, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	ext := strings.ToLower(filepath.Ext(filename))
+	switch {
+	case mt == "application/zip" && ext == ".docx":
+		return "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	case mt == "application/zip" && ext == ".odt":
+		return "application/vnd.oasis.opendocument.text"
+	case mt == "text/plain" && (ext == ".md" || ext == ".markdown"):
+		return "text/markdown"
+	}
+	return mt
+}
+
+func (h *AttachmentsHandler) applicationID(w http.ResponseWriter, r *http.Request) (int64, int64, bool) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return 0, 0, false
+	}
+	appID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || appID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return 0, 0, false
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+	if _, err := h.q.GetApplicationByID(ctx, repo.GetApplicationByIDParams{ID: appID, UserID: uid}); err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return 0, 0, false
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return 0, 0, false
+	}
+	return uid, appID, true
+}
+
+// @Summary      Upload attachment
+// @Description  multipart/form-data: file (pdf, docx, odt, txt, md) ve kind (resume | cover_letter | other).
+// @Description  Kullanıcı aynı içeriği (SHA-256) tekrar yüklerse kendi mevcut dosyası kullanılır.
+// @Tags         attachments
+// @Security     BearerAuth
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id    path      int64   true   "application id"
+// @Param        file  formData  file    true   "dosya"
+// @Param        kind  formData  string  false  "resume | cover_letter | other"
+// @Success      201   {object}  map[string]any
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Failure      413   {object}  map[string]string
+// @Failure      415   {object}  map[string]string
+// @Router       /v1/applications/{id}/attachments [post]
+func (h *AttachmentsHandler) upload(w http.ResponseWriter, r *http.Request) {
+	uid, appID, ok := h.applicationID(w, r)
+	if !ok {
+		return
+	}
+
+	// multipart başlıkları için küçük pay
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxBytes+64<<10)
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		var mbe *http.MaxBytesError
+		if errors.As(err, &mbe) {
+			writeError(w, http.StatusRequestEntityTooLarge, "file too large (max "+strconv.FormatInt(h.maxBytes, 10)+" bytes)")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	kind := r.FormValue("kind")
+	if kind == "" {
+		kind = "other"
+	}
+	if !attachmentKinds[kind] {
+		writeError(w, http.StatusBadRequest, "invalid kind, must be one of: resume, cover_letter, other")
+		return
+	}
+
+	file, fh, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "file required")
+		return
+	}
+	defer file.Close()
+
+	if fh.Size > h.maxBytes {
+		writeError(w, http.StatusRequestEntityTooLarge, "file too large (max "+strconv.FormatInt(h.maxBytes, 10)+" bytes)")
+		return
+	}
+	if fh.Size == 0 {
+		writeError(w, http.StatusBadRequest, "file is empty")
+		return
+	}
+	filename := strings.TrimSpace(filepath.Base(fh.Filename))
+	if filename == "" || filename == "." || len(filename) > 255 {
+		writeError(w, http.StatusBadRequest, "invalid filename")
+		return
+	}
+
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(file, head)
+	contentType := detectAttachmentMIME(head[:n], filename)
+	if !attachmentMIMEs[contentType] {
+		writeError(w, http.StatusUnsupportedMediaType, "unsupported file type: "+contentType)
+		return
+	}
+
+	hash := sha256.New()
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		writeError(w, http.StatusInternalServerError, "read error")
+		return
+	}
+	if _, err := io.Copy(hash, file); err != nil {
+		writeError(w, http.StatusInternalServerError, "read error")
+		return
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+	// anahtar kullanıcıya özel: başka kullanıcıların aynı içeriği yükleyip
+	// yüklemediği Exists sonucundan anlaşılamasın
+	key := "u/" + strconv.FormatInt(uid, 10) + "/sha256/" + sum[:2] + "/" + sum
+
+	ctx, cancel := context.WithTimeout(r.Context(), 60*time.Second)
+	defer cancel()
+
+	// içerik adresli: kullanıcının aynı dosyası storage'a bir kez yazılır
+	exists, err := h.store.Exists(ctx, key)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "storage error: "+err.Error())
+		return
+	}
+	if !exists {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			writeError(w, http.StatusInternalServerError, "read error")
+			return
+		}
+		if err := h.store.Put(ctx, key, file, fh.Size, contentType); err != nil {
+			writeError(w, http.StatusInternalServerError, "storage error: "+err.Error())
+			return
+		}
+	}
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	att, err := qtx.UpsertAttachment(ctx, repo.UpsertAttachmentParams{
+		UserID:      uid,
+		Sha256:      sum,
+		SizeBytes:   fh.Size,
+		ContentType: contentType,
+		StorageKey:  key,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	link, err := qtx.LinkApplicationAttachment(ctx, repo.LinkApplicationAttachmentParams{
+		ApplicationID: appID,
+		AttachmentID:  att.ID,
+		Kind:          kind,
+		Filename:      filename,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventAttachmentAdded, map[string]any{
+		"application_id": appID,
+		"attachment_id":  link.ID,
+		"kind":           kind,
+		"filename":       filename,
+		"sha256":         sum,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"attachment": h.view(r, link, att),
+	})
+}
+
+// @Summary      List attachments
+// @Description  Her ek için süreli imzalı indirme linki döner
+// @Tags         attachments
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  int64  true  "application id"
+// @Success      200  {object}  map[string]any
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/attachments [get]
+func (h *AttachmentsHandler) list(w http.ResponseWriter, r *http.Request) {
+	_, appID, ok := h.applicationID(w, r)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	rows, err := h.q.ListApplicationAttachments(ctx, appID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	items := make([]attachmentView, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, h.view(r, row.ApplicationAttachment, row.Attachment))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": items})
+}
+
+// @Summary      Remove attachment
+// @Description  Eki başvurudan ayırır; aynı dosyayı kullanan diğer başvurular etkilenmez. Eski indirme linkleri geçersiz olur.
+// @Tags         attachments
+// @Security     BearerAuth
+// @Param        id            path  int64  true  "application id"
+// @Param        attachmentId  path  int64  true  "attachment id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/attachments/{attachmentId} [delete]
+func (h *AttachmentsHandler) unlink(w http.ResponseWriter, r *http.Request) {
+	uid, appID, ok := h.applicationID(w, r)
+	if !ok {
+		return
+	}
+	linkID, err := strconv.ParseInt(chi.URLParam(r, "attachmentId"), 10, 64)
+	if err != nil || linkID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid attachment id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	n, err := qtx.UnlinkApplicationAttachment(ctx, repo.UnlinkApplicationAttachmentParams{ID: linkID, ApplicationID: appID})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 {
+		writeError(w, http.StatusNotFound, "attachment not found")
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventAttachmentRemoved, map[string]any{
+		"application_id": appID,
+		"attachment_id":  linkID,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Download attachment
+// @Description  Liste/yükleme yanıtındaki imzalı link. S3 backend'de süreli S3 linkine yönlendirir.
+// @Tags         attachments
+// @Param        id       path   int64   true  "attachment id"
+// @Param        expires  query  int64   true  "unix zamanı"
+// @Param        sig      query  string  true  "imza"
+// @Success      200  {file}    file
+// @Success      302  "S3 presigned URL"
+// @Failure      403  {object}  map[string]string
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/attachments/{id}/download [get]
+func (h *AttachmentsHandler) download(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	linkID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || linkID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+	expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusForbidden, "invalid or expired link")
+		return
+	}
+	now := time.Now()
+	if !auth.VerifyPath("/v1/attachments/"+idStr+"/download", expires, r.URL.Query().Get("sig"), now) {
+		writeError(w, http.StatusForbidden, "invalid or expired link")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 60*time.Second)
+	defer cancel()
+
+	row, err := h.q.GetApplicationAttachment(ctx, linkID)
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "attachment not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	att, link := row.Attachment, row.ApplicationAttachment
+
+	// S3: dosyayı API üzerinden akıtmak yerine kalan süre kadar geçerli S3 linki
+	if p, ok := h.store.(storage.Presigner); ok {
+		ttl := time.Unix(expires, 0).Sub(now)
+		u, err := p.PresignGet(att.StorageKey, ttl, link.Filename, att.ContentType)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "storage error: "+err.Error())
+			return
+		}
+		http.Redirect(w, r, u, http.StatusFound)
+		return
+	}
+
+	rc, err := h.store.Get(ctx, att.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			writeError(w, http.StatusNotFound, "attachment content missing")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "storage error: "+err.Error())
+		return
+	}
+	defer rc.Close()
+
+	w.Header().Set("Content-Type", att.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(att.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", storage.ContentDisposition(link.Filename))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(w, rc)
+}
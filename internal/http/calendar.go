@@ -0,0 +1,208 @@
+package httpx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Ali0NAL/talentpass/internal/auth"
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+// takip (next_action_at) etkinliklerinin takvimdeki süresi
+const followUpEventDuration = 30 * time.Minute
+
+// CalendarHandler: iCalendar abonelik feed'i. Feed token ile açılır (takvim
+// istemcileri Authorization header gönderemez); token yönetimi JWT ister.
+type CalendarHandler struct {
+	q    *repo.Queries
+	pool *pgxpool.Pool
+}
+
+func NewCalendarHandler(pool *pgxpool.Pool) *CalendarHandler {
+	return &CalendarHandler{q: repo.New(pool), pool: pool}
+}
+
+func (h *CalendarHandler) Router() http.Handler {
+	r := newSubrouter()
+	r.Get("/{token}.ics", h.feed) // GET /v1/calendar/{token}.ics (public)
+	r.Group(func(pr chi.Router) {
+		pr.Use(RequireAuth)
+		pr.Post("/token", h.regenerateToken) // POST   /v1/calendar/token
+		pr.Delete("/token", h.revokeToken)   // DELETE /v1/calendar/token
+	})
+	return r
+}
+
+// @Summary      Regenerate calendar token
+// @Description  Yeni abonelik linki üretir; önceki link geçersiz olur. Token sadece bu yanıtta görünür.
+// @Tags         calendar
+// @Security     BearerAuth
+// @Produce      json
+// @Success      201  {object}  map[string]any
+// @Failure      401  {object}  map[string]string
+// @Router       /v1/calendar/token [post]
+func (h *CalendarHandler) regenerateToken(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	plain, hash, err := auth.NewCalendarToken()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "token error")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	ct, err := h.q.UpsertCalendarToken(ctx, repo.UpsertCalendarTokenParams{
+		UserID:    uid,
+		TokenHash: hash,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"token":      plain,
+		"url":        requestBaseURL(r) + "/v1/calendar/" + plain + ".ics",
+		"created_at": ct.CreatedAt,
+	})
+}
+
+// @Summary      Revoke calendar token
+// @Tags         calendar
+// @Security     BearerAuth
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/calendar/token [delete]
+func (h *CalendarHandler) revokeToken(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	n, err := h.q.DeleteCalendarToken(ctx, uid)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 {
+		writeError(w, http.StatusNotFound, "calendar token not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Calendar feed
+// @Description  RFC 5545 iCalendar: başvuruların next_action_at tarihleri ve mülakatlar. Google/Outlook/Thunderbird ile abone olunabilir.
+// @Tags         calendar
+// @Produce      text/calendar
+// @Param        token  path  string  true  "calendar token"
+// @Success      200    {string}  string  "text/calendar"
+// @Failure      404    {object}  map[string]string
+// @Router       /v1/calendar/{token}.ics [get]
+func (h *CalendarHandler) feed(w http.ResponseWriter, r *http.Request) {
+	token := chi.URLParam(r, "token")
+	if token == "" {
+		writeError(w, http.StatusNotFound, "calendar not found")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	ct, err := h.q.GetCalendarTokenByHash(ctx, auth.HashCalendarToken(token))
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "calendar not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	events, err := h.calendarEvents(ctx, ct.UserID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="talentpass.ics"`)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(renderICal("TalentPass", events)))
+}
+
+// calendarEvents: kullanıcının takvimdeki tüm etkinlikleri
+func (h *CalendarHandler) calendarEvents(ctx context.Context, uid int64) ([]icalEvent, error) {
+	actions, err := h.q.ListCalendarNextActions(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]icalEvent, 0, len(actions))
+	for _, a := range actions {
+		ev := icalEvent{
+			// başvuru başına tek takip etkinliği: tarih değişince aynı UID güncellenir
+			UID:         fmt.Sprintf("application-%d-next-action@talentpass", a.ID),
+			Start:       a.NextActionAt,
+			End:         a.NextActionAt.Add(followUpEventDuration),
+			Stamp:       a.UpdatedAt,
+			Summary:     "Takip: " + a.Company + " - " + a.Title,
+			Description: "Başvuru #" + fmt.Sprint(a.ID) + " (" + a.Status + ")",
+		}
+		if a.Url != nil {
+			ev.URL = *a.Url
+		}
+		events = append(events, ev)
+	}
+
+	interviews, err := h.q.ListCalendarInterviews(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	for _, iv := range interviews {
+		ev := icalEvent{
+			UID:     fmt.Sprintf("interview-%d@talentpass", iv.ID),
+			Start:   iv.StartsAt,
+			End:     iv.EndsAt,
+			Stamp:   iv.UpdatedAt,
+			Summary: "Mülakat: " + iv.Company + " - " + iv.RoundName + " (" + iv.Type + ")",
+			Description: "Başvuru #" + fmt.Sprint(iv.ApplicationID) + " - " + iv.Title +
+				"\nMülakatçılar: " + strings.Join(iv.Interviewers, ", "),
+		}
+		if iv.Location != nil {
+			ev.Location = *iv.Location
+		}
+		if iv.MeetingUrl != nil {
+			ev.URL = *iv.MeetingUrl
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// requestBaseURL: proxy arkasında X-Forwarded-Proto'ya bakarak scheme://host
+func requestBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if p := r.Header.Get("X-Forwarded-Proto"); p != "" {
+		scheme = strings.ToLower(strings.TrimSpace(strings.Split(p, ",")[0]))
+	}
+	return scheme + "://" + r.Host
+}
@@ -0,0 +1,37 @@
+package httpx
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// canonicalURL: aynı ilanın farklı linklerini tek forma indirger.
+// Şema/host küçük harfe çevrilir, fragment ve utm_* parametreleri atılır,
+// kalan query parametreleri sıralanır ve sondaki "/" kaldırılır.
+func canonicalURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", errors.New("url must be absolute")
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.User = nil
+
+	q := u.Query()
+	for k := range q {
+		if strings.HasPrefix(strings.ToLower(k), "utm_") {
+			q.Del(k)
+		}
+	}
+	u.RawQuery = q.Encode() // Encode anahtarları sıralar
+
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+	return u.String(), nil
+}
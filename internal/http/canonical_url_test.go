@@ -0,0 +1,41 @@
+package httpx
+
+import "testing"
+
+func TestCanonicalURL(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"scheme and host lowercased", "HTTPS://Example.COM/jobs/1", "https://example.com/jobs/1"},
+		{"path case kept", "https://example.com/Jobs/ABC", "https://example.com/Jobs/ABC"},
+		{"trailing slash", "https://example.com/jobs/1/", "https://example.com/jobs/1"},
+		{"root", "https://example.com/", "https://example.com"},
+		{"fragment dropped", "https://example.com/jobs/1#apply", "https://example.com/jobs/1"},
+		{"utm dropped", "https://example.com/jobs/1?utm_source=li&utm_medium=x", "https://example.com/jobs/1"},
+		{"utm case-insensitive", "https://example.com/jobs/1?UTM_Campaign=y", "https://example.com/jobs/1"},
+		{"query sorted", "https://example.com/jobs?b=2&utm_source=x&a=1", "https://example.com/jobs?a=1&b=2"},
+		{"userinfo dropped", "https://user:pw@example.com/a", "https://example.com/a"},
+		{"whitespace trimmed", "  https://example.com/a \n", "https://example.com/a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := canonicalURL(tt.in)
+			if err != nil {
+				t.Fatalf("canonicalURL(%q) error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Fatalf("canonicalURL(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalURLRejectsRelative(t *testing.T) {
+	for _, in := range []string{"", "/jobs/1", "example.com/jobs/1", "://bad"} {
+		if got, err := canonicalURL(in); err == nil {
+			t.Errorf("canonicalURL(%q) = %q, want error", in, got)
+		}
+	}
+}
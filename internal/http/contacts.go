@@ -0,0 +1,726 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+var contactInteractionKinds = map[string]bool{
+	"email": true, "call": true, "meeting": true, "message": true, "linkedin": true, "other": true,
+}
+
+// ContactsHandler: kullanıcının kişi rehberi (recruiter, hiring manager, referans)
+type ContactsHandler struct {
+	q    *repo.Queries
+	pool *pgxpool.Pool
+}
+
+func NewContactsHandler(pool *pgxpool.Pool) *ContactsHandler {
+	return &ContactsHandler{q: repo.New(pool), pool: pool}
+}
+
+func (h *ContactsHandler) Router() http.Handler {
+	r := chi.NewRouter()
+	r.Post("/", h.create)                                       // POST   /v1/contacts
+	r.Get("/", h.list)                                          // GET    /v1/contacts
+	r.Get("/{id}", h.get)                                       // GET    /v1/contacts/{id}
+	r.Patch("/{id}", h.patch)                                   // PATCH  /v1/contacts/{id}
+	r.Delete("/{id}", h.delete)                                 // DELETE /v1/contacts/{id}
+	r.Put("/{id}/jobs/{jobId}", h.linkJob)                      // PUT    /v1/contacts/{id}/jobs/{jobId}
+	r.Delete("/{id}/jobs/{jobId}", h.unlinkJob)                 // DELETE /v1/contacts/{id}/jobs/{jobId}
+	r.Put("/{id}/applications/{appId}", h.linkApplication)      // PUT    /v1/contacts/{id}/applications/{appId}
+	r.Delete("/{id}/applications/{appId}", h.unlinkApplication) // DELETE /v1/contacts/{id}/applications/{appId}
+	r.Post("/{id}/interactions", h.logInteraction)              // POST   /v1/contacts/{id}/interactions
+	r.Get("/{id}/interactions", h.listInteractions)             // GET    /v1/contacts/{id}/interactions
+	return r
+}
+
+// ContactReq: create gövdesi; PATCH'te mevcut kayıt bu yapıya doldurulup gövde
+// üzerine decode edilir (merge patch: null opsiyonel alanı temizler).
+type ContactReq struct {
+	Name        string  `json:"name"`
+	Email       *string `json:"email"`
+	Phone       *string `json:"phone"`
+	LinkedinURL *string `json:"linkedin_url"`
+	Company     *string `json:"company"`
+	Role        *string `json:"role"`
+	Notes       *string `json:"notes"`
+}
+
+// trimmedOrNil: boş string NULL olarak saklanır
+func trimmedOrNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func (req ContactReq) validate() (ContactReq, error) {
+	f := ContactReq{
+		Name:        strings.TrimSpace(req.Name),
+		Email:       trimmedOrNil(req.Email),
+		Phone:       trimmedOrNil(req.Phone),
+		LinkedinURL: trimmedOrNil(req.LinkedinURL),
+		Company:     trimmedOrNil(req.Company),
+		Role:        trimmedOrNil(req.Role),
+		Notes:       trimmedOrNil(req.Notes),
+	}
+	if f.Name == "" {
+		return f, errors.New("name required")
+	}
+	if f.Email != nil {
+		addr, err := mail.ParseAddress(*f.Email)
+		if err != nil || addr.Name != "" {
+			return f, errors.New("invalid email")
+		}
+		e := strings.ToLower(addr.Address)
+		f.Email = &e
+	}
+	if f.LinkedinURL != nil {
+		u, err := url.Parse(*f.LinkedinURL)
+		host := ""
+		if err == nil {
+			host = strings.ToLower(u.Hostname())
+		}
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") ||
+			(host != "linkedin.com" && !strings.HasSuffix(host, ".linkedin.com")) {
+			return f, errors.New("invalid linkedin_url")
+		}
+	}
+	return f, nil
+}
+
+func contactReqFrom(c repo.Contact) ContactReq {
+	return ContactReq{
+		Name:        c.Name,
+		Email:       c.Email,
+		Phone:       c.Phone,
+		LinkedinURL: c.LinkedinUrl,
+		Company:     c.Company,
+		Role:        c.Role,
+		Notes:       c.Notes,
+	}
+}
+
+type contactView struct {
+	repo.Contact
+	JobIDs         []int64 `json:"job_ids"`
+	ApplicationIDs []int64 `json:"application_ids"`
+}
+
+// pathID: chi path parametresini pozitif int64 olarak okur
+func pathID(w http.ResponseWriter, r *http.Request, name, msg string) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, name), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, msg)
+		return 0, false
+	}
+	return id, true
+}
+
+// loadContact: kişinin kullanıcıya ait olduğunu doğrular (değilse 404)
+func (h *ContactsHandler) loadContact(ctx context.Context, w http.ResponseWriter, q *repo.Queries, id, uid int64) (repo.Contact, bool) {
+	c, err := q.GetContact(ctx, repo.GetContactParams{ID: id, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "contact not found")
+			return c, false
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return c, false
+	}
+	return c, true
+}
+
+// @Summary      Create contact
+// @Tags         contacts
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      ContactReq  true  "contact payload"
+// @Success      201   {object}  repo.Contact
+// @Failure      400   {object}  map[string]string
+// @Router       /v1/contacts [post]
+func (h *ContactsHandler) create(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req ContactReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	f, err := req.validate()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	c, err := h.q.CreateContact(ctx, repo.CreateContactParams{
+		UserID:      uid,
+		Name:        f.Name,
+		Email:       f.Email,
+		Phone:       f.Phone,
+		LinkedinUrl: f.LinkedinURL,
+		Company:     f.Company,
+		Role:        f.Role,
+		Notes:       f.Notes,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, c)
+}
+
+// @Summary      List contacts
+// @Description  q: ad, e-posta, şirket, rol ve notlarda arama. job_id / application_id: o kayda bağlı kişiler.
+// @Tags         contacts
+// @Security     BearerAuth
+// @Produce      json
+// @Param        q               query  string  false  "arama"
+// @Param        company         query  string  false  "şirket (içerir)"
+// @Param        job_id          query  int64   false  "ilana bağlı kişiler"
+// @Param        application_id  query  int64   false  "başvuruya bağlı kişiler"
+// @Param        limit           query  int     false  "limit (1-100)"
+// @Param        offset          query  int     false  "offset"
+// @Success      200  {object}  map[string]any
+// @Failure      400  {object}  map[string]string
+// @Router       /v1/contacts [get]
+func (h *ContactsHandler) list(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	q := r.URL.Query()
+	params := repo.ListContactsParams{UserID: uid, Limit: 20, Offset: 0}
+	if s := strings.TrimSpace(q.Get("q")); s != "" {
+		params.Q = &s
+	}
+	if s := strings.TrimSpace(q.Get("company")); s != "" {
+		params.Company = &s
+	}
+	if s := q.Get("job_id"); s != "" {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || v <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid job_id")
+			return
+		}
+		params.JobID = &v
+	}
+	if s := q.Get("application_id"); s != "" {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || v <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid application_id")
+			return
+		}
+		params.ApplicationID = &v
+	}
+	if s := q.Get("limit"); s != "" {
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v > 0 && v <= 100 {
+			params.Limit = int32(v)
+		}
+	}
+	if s := q.Get("offset"); s != "" {
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v >= 0 {
+			params.Offset = int32(v)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	items, err := h.q.ListContacts(ctx, params)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if items == nil {
+		items = []repo.Contact{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"items":  items,
+		"limit":  params.Limit,
+		"offset": params.Offset,
+	})
+}
+
+// @Summary      Get contact
+// @Description  Bağlı ilan ve başvuru id'leriyle birlikte
+// @Tags         contacts
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path      int64  true  "contact id"
+// @Success      200  {object}  contactView
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/contacts/{id} [get]
+func (h *ContactsHandler) get(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	c, ok := h.loadContact(ctx, w, h.q, id, uid)
+	if !ok {
+		return
+	}
+	v := contactView{Contact: c, JobIDs: []int64{}, ApplicationIDs: []int64{}}
+	jobIDs, err := h.q.ListContactJobIDs(ctx, id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	appIDs, err := h.q.ListContactApplicationIDs(ctx, id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	v.JobIDs = append(v.JobIDs, jobIDs...)
+	v.ApplicationIDs = append(v.ApplicationIDs, appIDs...)
+	writeJSON(w, http.StatusOK, v)
+}
+
+// @Summary      Update contact (merge patch)
+// @Description  JSON Merge Patch: verilmeyen alan değişmez, null opsiyonel alanı temizler.
+// @Tags         contacts
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int64       true  "contact id"
+// @Param        body  body      ContactReq  true  "patch"
+// @Success      200   {object}  repo.Contact
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Router       /v1/contacts/{id} [patch]
+func (h *ContactsHandler) patch(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	cur, ok := h.loadContact(ctx, w, h.q, id, uid)
+	if !ok {
+		return
+	}
+	req := contactReqFrom(cur)
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	f, err := req.validate()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c, err := h.q.UpdateContact(ctx, repo.UpdateContactParams{
+		Name:        f.Name,
+		Email:       f.Email,
+		Phone:       f.Phone,
+		LinkedinUrl: f.LinkedinURL,
+		Company:     f.Company,
+		Role:        f.Role,
+		Notes:       f.Notes,
+		ID:          id,
+		UserID:      uid,
+	})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "contact not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, c)
+}
+
+// @Summary      Delete contact
+// @Description  İlan/başvuru bağlantıları da silinir
+// @Tags         contacts
+// @Security     BearerAuth
+// @Param        id   path  int64  true  "contact id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/contacts/{id} [delete]
+func (h *ContactsHandler) delete(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	n, err := h.q.DeleteContact(ctx, repo.DeleteContactParams{ID: id, UserID: uid})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 {
+		writeError(w, http.StatusNotFound, "contact not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// contactLink: bağlama/ayırma işlemlerinin ortak akışı; op, transaction içinde
+// bağlantıyı yazar/siler ve etkilenen satır sayısını döner. Yalnızca gerçek değişiklikte
+// kişinin etkileşim kaydına event düşülür. Bağlanacak ilan kullanıcıya görünür
+// olmalıdır; görünmeyen ilan 404 döner.
+func (h *ContactsHandler) contactLink(w http.ResponseWriter, r *http.Request, link bool, appID, jobID *int64, payload map[string]any,
+	op func(ctx context.Context, qtx *repo.Queries, contactID int64) (int64, error)) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	if _, ok := h.loadContact(ctx, w, qtx, id, uid); !ok {
+		return
+	}
+	if appID != nil {
+		if _, err := qtx.GetApplicationByID(ctx, repo.GetApplicationByIDParams{ID: *appID, UserID: uid}); err != nil {
+			if isNoRows(err) {
+				writeError(w, http.StatusNotFound, "application not found")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+	if jobID != nil && link {
+		if _, ok := loadVisibleJob(ctx, w, qtx, *jobID, uid); !ok {
+			return
+		}
+	}
+
+	n, err := op(ctx, qtx, id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			writeError(w, http.StatusNotFound, "job not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 {
+		if link {
+			// zaten bağlı: idempotent
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeError(w, http.StatusNotFound, "link not found")
+		return
+	}
+	typ := eventContactUnlinked
+	if link {
+		typ = eventContactLinked
+	}
+	payload["contact_id"] = id
+	if err := recordContactEvent(ctx, qtx, uid, id, appID, typ, payload); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Link contact to job
+// @Tags         contacts
+// @Security     BearerAuth
+// @Param        id     path  int64  true  "contact id"
+// @Param        jobId  path  int64  true  "job id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/contacts/{id}/jobs/{jobId} [put]
+func (h *ContactsHandler) linkJob(w http.ResponseWriter, r *http.Request) {
+	jobID, ok := pathID(w, r, "jobId", "invalid job id")
+	if !ok {
+		return
+	}
+	h.contactLink(w, r, true, nil, &jobID, map[string]any{"job_id": jobID},
+		func(ctx context.Context, qtx *repo.Queries, contactID int64) (int64, error) {
+			return qtx.LinkContactJob(ctx, repo.LinkContactJobParams{ContactID: contactID, JobID: jobID})
+		})
+}
+
+// @Summary      Unlink contact from job
+// @Tags         contacts
+// @Security     BearerAuth
+// @Param        id     path  int64  true  "contact id"
+// @Param        jobId  path  int64  true  "job id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/contacts/{id}/jobs/{jobId} [delete]
+func (h *ContactsHandler) unlinkJob(w http.ResponseWriter, r *http.Request) {
+	jobID, ok := pathID(w, r, "jobId", "invalid job id")
+	if !ok {
+		return
+	}
+	h.contactLink(w, r, false, nil, &jobID, map[string]any{"job_id": jobID},
+		func(ctx context.Context, qtx *repo.Queries, contactID int64) (int64, error) {
+			return qtx.UnlinkContactJob(ctx, repo.UnlinkContactJobParams{ContactID: contactID, JobID: jobID})
+		})
+}
+
+// @Summary      Link contact to application
+// @Tags         contacts
+// @Security     BearerAuth
+// @Param        id     path  int64  true  "contact id"
+// @Param        appId  path  int64  true  "application id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/contacts/{id}/applications/{appId} [put]
+func (h *ContactsHandler) linkApplication(w http.ResponseWriter, r *http.Request) {
+	appID, ok := pathID(w, r, "appId", "invalid application id")
+	if !ok {
+		return
+	}
+	h.contactLink(w, r, true, &appID, nil, map[string]any{"application_id": appID},
+		func(ctx context.Context, qtx *repo.Queries, contactID int64) (int64, error) {
+			return qtx.LinkContactApplication(ctx, repo.LinkContactApplicationParams{ContactID: contactID, ApplicationID: appID})
+		})
+}
+
+// @Summary      Unlink contact from application
+// @Tags         contacts
+// @Security     BearerAuth
+// @Param        id     path  int64  true  "contact id"
+// @Param        appId  path  int64  true  "application id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/contacts/{id}/applications/{appId} [delete]
+func (h *ContactsHandler) unlinkApplication(w http.ResponseWriter, r *http.Request) {
+	appID, ok := pathID(w, r, "appId", "invalid application id")
+	if !ok {
+		return
+	}
+	h.contactLink(w, r, false, &appID, nil, map[string]any{"application_id": appID},
+		func(ctx context.Context, qtx *repo.Queries, contactID int64) (int64, error) {
+			return qtx.UnlinkContactApplication(ctx, repo.UnlinkContactApplicationParams{ContactID: contactID, ApplicationID: appID})
+		})
+}
+
+type InteractionReq struct {
+	Kind          string `json:"kind"`                     // email | call | meeting | message | linkedin | other
+	Summary       string `json:"summary"`                  // kısa açıklama
+	OccurredAt    string `json:"occurred_at,omitempty"`    // RFC3339, default şimdi
+	ApplicationID *int64 `json:"application_id,omitempty"` // optional: ilgili başvuru
+}
+
+// @Summary      Log interaction
+// @Description  Kişiyle yapılan görüşme / yazışmayı etkileşim kaydına ekler (events tablosu, contact.interaction).
+// @Tags         contacts
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int64           true  "contact id"
+// @Param        body  body      InteractionReq  true  "interaction"
+// @Success      201   {object}  repo.Event
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Router       /v1/contacts/{id}/interactions [post]
+func (h *ContactsHandler) logInteraction(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+
+	var req InteractionReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	if !contactInteractionKinds[req.Kind] {
+		writeError(w, http.StatusBadRequest, "invalid kind, must be one of: email, call, meeting, message, linkedin, other")
+		return
+	}
+	summary := strings.TrimSpace(req.Summary)
+	if summary == "" || len(summary) > 2000 {
+		writeError(w, http.StatusBadRequest, "summary required (max 2000 chars)")
+		return
+	}
+	occurredAt := time.Now().UTC()
+	if req.OccurredAt != "" {
+		t, err := time.Parse(time.RFC3339, req.OccurredAt)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid occurred_at (RFC3339)")
+			return
+		}
+		occurredAt = t
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if _, ok := h.loadContact(ctx, w, h.q, id, uid); !ok {
+		return
+	}
+	if req.ApplicationID != nil {
+		if _, err := h.q.GetApplicationByID(ctx, repo.GetApplicationByIDParams{ID: *req.ApplicationID, UserID: uid}); err != nil {
+			if isNoRows(err) {
+				writeError(w, http.StatusNotFound, "application not found")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+
+	payload := map[string]any{
+		"contact_id":  id,
+		"kind":        req.Kind,
+		"summary":     summary,
+		"occurred_at": occurredAt.Format(time.RFC3339),
+	}
+	if req.ApplicationID != nil {
+		payload["application_id"] = *req.ApplicationID
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "encode error")
+		return
+	}
+	ev, err := h.q.CreateEvent(ctx, repo.CreateEventParams{
+		UserID:        &uid,
+		ApplicationID: req.ApplicationID,
+		ContactID:     &id,
+		Type:          eventContactInteraction,
+		PayloadJson:   b,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, ev)
+}
+
+// @Summary      List interactions
+// @Description  Kişinin etkileşim kaydı: kaydedilen etkileşimler ve ilan/başvuru bağlama olayları, yeniden eskiye.
+// @Tags         contacts
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id      path   int64  true   "contact id"
+// @Param        limit   query  int    false  "limit (1-100)"
+// @Param        offset  query  int    false  "offset"
+// @Success      200  {object}  map[string]any
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/contacts/{id}/interactions [get]
+func (h *ContactsHandler) listInteractions(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+	params := repo.ListContactEventsParams{ContactID: id, Limit: 50, Offset: 0}
+	q := r.URL.Query()
+	if s := q.Get("limit"); s != "" {
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v > 0 && v <= 100 {
+			params.Limit = int32(v)
+		}
+	}
+	if s := q.Get("offset"); s != "" {
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v >= 0 {
+			params.Offset = int32(v)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if _, ok := h.loadContact(ctx, w, h.q, id, uid); !ok {
+		return
+	}
+	items, err := h.q.ListContactEvents(ctx, params)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if items == nil {
+		items = []repo.Event{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"items":  items,
+		"limit":  params.Limit,
+		"offset": params.Offset,
+	})
+}
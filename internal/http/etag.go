@@ -0,0 +1,56 @@
+package httpx
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+// jobETag: ilan id + updated_at (mikrosaniye) üzerinden strong ETag.
+// Her UpdateJob updated_at'i now() yaptığı için versiyon gibi davranır.
+func jobETag(j repo.Job) string {
+	ts := j.CreatedAt
+	if j.UpdatedAt != nil {
+		ts = *j.UpdatedAt
+	}
+	return `"` + strconv.FormatInt(j.ID, 36) + "-" + strconv.FormatInt(ts.UnixMicro(), 36) + `"`
+}
+
+// etagMatches: If-Match / If-None-Match başlığındaki listeden biri etag ile eşleşiyor mu?
+// "*" her mevcut kaynakla eşleşir. weak=true ise W/ önekleri yok sayılır (If-None-Match).
+func etagMatches(header, etag string, weak bool) bool {
+	for _, part := range strings.Split(header, ",") {
+		tag := strings.TrimSpace(part)
+		if tag == "*" {
+			return true
+		}
+		if weak {
+			tag = strings.TrimPrefix(tag, "W/")
+		}
+		if tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// checkJobIfMatch: If-Match varsa güncel ETag ile karşılaştırır (uyuşmazsa 412).
+// Org ilanları birden çok kişi tarafından düzenlendiği için orada başlık zorunludur (428).
+func checkJobIfMatch(w http.ResponseWriter, r *http.Request, job repo.Job) bool {
+	im := r.Header.Get("If-Match")
+	if im == "" {
+		if job.OrgID != nil {
+			writeError(w, http.StatusPreconditionRequired, "If-Match header required for org jobs")
+			return false
+		}
+		return true
+	}
+	if !etagMatches(im, jobETag(job), false) {
+		w.Header().Set("ETag", jobETag(job))
+		writeError(w, http.StatusPreconditionFailed, "job has been modified")
+		return false
+	}
+	return true
+}
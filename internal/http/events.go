@@ -0,0 +1,73 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+// events.type değerleri
+const (
+	eventApplicationCreated       = "application.created"
+	eventApplicationStatusChanged = "application.status.changed"
+	eventApplicationUpdated       = "application.updated"
+	eventApplicationDeleted       = "application.deleted"
+
+	eventApplicationReminderSnoozed   = "application.reminder.snoozed"
+	eventApplicationReminderCompleted = "application.reminder.completed"
+
+	eventInterviewScheduled = "application.interview.scheduled"
+	eventInterviewUpdated   = "application.interview.updated"
+	eventInterviewDeleted   = "application.interview.deleted"
+
+	eventOfferReceived = "application.offer.received"
+	eventOfferUpdated  = "application.offer.updated"
+	eventOfferDeleted  = "application.offer.deleted"
+
+	eventApplicationLabelAdded   = "application.label.added"
+	eventApplicationLabelRemoved = "application.label.removed"
+
+	eventNoteCreated = "application.note.created"
+	eventNoteUpdated = "application.note.updated"
+	eventNoteDeleted = "application.note.deleted"
+
+	eventAttachmentAdded   = "application.attachment.added"
+	eventAttachmentRemoved = "application.attachment.removed"
+
+	eventContactInteraction = "contact.interaction"
+	eventContactLinked      = "contact.linked"
+	eventContactUnlinked    = "contact.unlinked"
+)
+
+// recordEvent: audit event yazar. Çağıran, event'in iş değişikliğiyle birlikte
+// commit olması için transaction'lı Queries vermelidir.
+func recordEvent(ctx context.Context, q *repo.Queries, uid int64, appID *int64, typ string, payload map[string]any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = q.CreateEvent(ctx, repo.CreateEventParams{
+		UserID:        &uid,
+		ApplicationID: appID,
+		Type:          typ,
+		PayloadJson:   b,
+	})
+	return err
+}
+
+// recordContactEvent: kişinin etkileşim kaydına düşen event (events.contact_id dolu)
+func recordContactEvent(ctx context.Context, q *repo.Queries, uid, contactID int64, appID *int64, typ string, payload map[string]any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = q.CreateEvent(ctx, repo.CreateEventParams{
+		UserID:        &uid,
+		ApplicationID: appID,
+		ContactID:     &contactID,
+		Type:          typ,
+		PayloadJson:   b,
+	})
+	return err
+}
@@ -0,0 +1,87 @@
+package httpx
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// RFC 5545 çıktısı için küçük yardımcılar
+
+const icalTimeFormat = "20060102T150405Z"
+
+type icalEvent struct {
+	UID         string // feed'ler arasında sabit kalmalı; takvim istemcileri güncellemeyi buna göre eşler
+	Start       time.Time
+	End         time.Time
+	Stamp       time.Time
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+}
+
+type icalWriter struct {
+	b strings.Builder
+}
+
+// line: "NAME:value" yazar; 75 octet'i aşan satırları CRLF + boşluk ile katlar.
+// Devam satırları baştaki boşlukla birlikte 75 octet'e sığsın diye 74 baytta kesilir.
+func (w *icalWriter) line(name, value string) {
+	s := name + ":" + value
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut-- // çok baytlı karakteri bölme
+		}
+		w.b.WriteString(s[:cut] + "\r\n ")
+		s = s[cut:]
+		limit = 74
+	}
+	w.b.WriteString(s + "\r\n")
+}
+
+func (w *icalWriter) event(ev icalEvent) {
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", ev.UID)
+	w.line("DTSTAMP", ev.Stamp.UTC().Format(icalTimeFormat))
+	w.line("DTSTART", ev.Start.UTC().Format(icalTimeFormat))
+	w.line("DTEND", ev.End.UTC().Format(icalTimeFormat))
+	w.line("SUMMARY", icalEscape(ev.Summary))
+	if ev.Description != "" {
+		w.line("DESCRIPTION", icalEscape(ev.Description))
+	}
+	if ev.Location != "" {
+		w.line("LOCATION", icalEscape(ev.Location))
+	}
+	if ev.URL != "" {
+		w.line("URL", ev.URL)
+	}
+	w.line("END", "VEVENT")
+}
+
+func (w *icalWriter) String() string {
+	return w.b.String()
+}
+
+// icalEscape: TEXT değerlerinde \ ; , ve satır sonlarını kaçırır
+func icalEscape(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+	return r.Replace(s)
+}
+
+func renderICal(name string, events []icalEvent) string {
+	var w icalWriter
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", "-//TalentPass//Calendar//TR")
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.line("X-WR-CALNAME", icalEscape(name))
+	for _, ev := range events {
+		w.event(ev)
+	}
+	w.line("END", "VCALENDAR")
+	return w.String()
+}
@@ -0,0 +1,68 @@
+package httpx
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestICalEscape(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain", "plain"},
+		{`a\b`, `a\\b`},
+		{"a;b,c", `a\;b\,c`},
+		{"a\r\nb", `a\nb`},
+		{"a\nb\rc", `a\nb\nc`},
+		{`\;`, `\\\;`},
+	}
+	for _, tt := range tests {
+		if got := icalEscape(tt.in); got != tt.want {
+			t.Errorf("icalEscape(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestICalLineFolding(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		lines int
+	}{
+		{"short", "kısa", 1},
+		{"exactly 75 octets", strings.Repeat("a", 75-len("SUMMARY:")), 1},
+		{"one over", strings.Repeat("a", 76-len("SUMMARY:")), 2},
+		{"long ascii", strings.Repeat("a", 200), 3},
+		{"multi-byte", strings.Repeat("ğ", 100), 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w icalWriter
+			w.line("SUMMARY", tt.value)
+			out := w.String()
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("output does not end with CRLF: %q", out)
+			}
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			if len(lines) != tt.lines {
+				t.Fatalf("got %d physical lines, want %d: %q", len(lines), tt.lines, out)
+			}
+			for i, l := range lines {
+				if len(l) > 75 {
+					t.Errorf("line %d is %d octets: %q", i, len(l), l)
+				}
+				if !utf8.ValidString(l) {
+					t.Errorf("line %d splits a multi-byte character: %q", i, l)
+				}
+				if i > 0 && !strings.HasPrefix(l, " ") {
+					t.Errorf("continuation line %d does not start with a space: %q", i, l)
+				}
+			}
+			// unfold (RFC 5545 3.1): CRLF + boşluk silinince orijinal satır çıkmalı
+			if got := strings.ReplaceAll(strings.TrimSuffix(out, "\r\n"), "\r\n ", ""); got != "SUMMARY:"+tt.value {
+				t.Fatalf("unfolded = %q, want %q", got, "SUMMARY:"+tt.value)
+			}
+		})
+	}
+}
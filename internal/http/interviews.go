@@ -0,0 +1,504 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+var (
+	interviewTypes    = map[string]bool{"phone": true, "technical": true, "onsite": true}
+	interviewOutcomes = map[string]bool{"pending": true, "passed": true, "failed": true, "cancelled": true}
+)
+
+// InterviewReq: create gövdesi; PATCH'te mevcut kayıt bu yapıya doldurulup gövde
+// üzerine decode edilir (merge patch: null location/meeting_url'i temizler).
+type InterviewReq struct {
+	RoundName    string   `json:"round_name"`
+	Type         string   `json:"type"`      // phone | technical | onsite
+	StartsAt     string   `json:"starts_at"` // RFC3339
+	EndsAt       string   `json:"ends_at"`   // RFC3339
+	Timezone     string   `json:"timezone"`  // IANA, örn. Europe/Istanbul (default UTC)
+	Location     *string  `json:"location"`
+	MeetingURL   *string  `json:"meeting_url"`
+	Interviewers []string `json:"interviewers"`
+	Outcome      string   `json:"outcome"` // pending | passed | failed | cancelled (default pending)
+}
+
+// interviewFields: doğrulanmış ve normalize edilmiş mülakat alanları
+type interviewFields struct {
+	RoundName    string
+	Type         string
+	StartsAt     time.Time
+	EndsAt       time.Time
+	Timezone     string
+	Location     *string
+	MeetingURL   *string
+	Interviewers []string
+	Outcome      string
+}
+
+func (req InterviewReq) validate() (interviewFields, error) {
+	f := interviewFields{
+		RoundName: strings.TrimSpace(req.RoundName),
+		Type:      req.Type,
+		Timezone:  req.Timezone,
+		Outcome:   req.Outcome,
+	}
+	if f.RoundName == "" {
+		return f, errors.New("round_name required")
+	}
+	if !interviewTypes[f.Type] {
+		return f, errors.New("invalid type, must be one of: phone, technical, onsite")
+	}
+	var err error
+	if f.StartsAt, err = time.Parse(time.RFC3339, req.StartsAt); err != nil {
+		return f, errors.New("invalid starts_at (RFC3339)")
+	}
+	if f.EndsAt, err = time.Parse(time.RFC3339, req.EndsAt); err != nil {
+		return f, errors.New("invalid ends_at (RFC3339)")
+	}
+	if !f.EndsAt.After(f.StartsAt) {
+		return f, errors.New("ends_at must be after starts_at")
+	}
+	if f.Timezone == "" {
+		f.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(f.Timezone); err != nil {
+		return f, errors.New("invalid timezone (IANA name, e.g. Europe/Istanbul)")
+	}
+	if f.Outcome == "" {
+		f.Outcome = "pending"
+	}
+	if !interviewOutcomes[f.Outcome] {
+		return f, errors.New("invalid outcome, must be one of: pending, passed, failed, cancelled")
+	}
+	if req.Location != nil {
+		if s := strings.TrimSpace(*req.Location); s != "" {
+			f.Location = &s
+		}
+	}
+	if req.MeetingURL != nil {
+		if s := strings.TrimSpace(*req.MeetingURL); s != "" {
+			u, err := url.Parse(s)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return f, errors.New("invalid meeting_url")
+			}
+			f.MeetingURL = &s
+		}
+	}
+	f.Interviewers = []string{}
+	for _, n := range req.Interviewers {
+		if n = strings.TrimSpace(n); n != "" {
+			f.Interviewers = append(f.Interviewers, n)
+		}
+	}
+	return f, nil
+}
+
+// interviewReqFrom: mevcut kaydı PATCH için istek yapısına çevirir
+func interviewReqFrom(iv repo.Interview) InterviewReq {
+	return InterviewReq{
+		RoundName:    iv.RoundName,
+		Type:         iv.Type,
+		StartsAt:     iv.StartsAt.Format(time.RFC3339),
+		EndsAt:       iv.EndsAt.Format(time.RFC3339),
+		Timezone:     iv.Timezone,
+		Location:     iv.Location,
+		MeetingURL:   iv.MeetingUrl,
+		Interviewers: iv.Interviewers,
+		Outcome:      iv.Outcome,
+	}
+}
+
+// localizeInterview: saatleri mülakatın kendi timezone'unda döner (JSON'da offset ile görünür)
+func localizeInterview(iv repo.Interview) repo.Interview {
+	if loc, err := time.LoadLocation(iv.Timezone); err == nil {
+		iv.StartsAt = iv.StartsAt.In(loc)
+		iv.EndsAt = iv.EndsAt.In(loc)
+	}
+	if iv.Interviewers == nil {
+		iv.Interviewers = []string{}
+	}
+	return iv
+}
+
+// interviewPathIDs: {id} ve {interviewId} path parametreleri
+func interviewPathIDs(w http.ResponseWriter, r *http.Request, withInterview bool) (appID, ivID int64, ok bool) {
+	appID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || appID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return 0, 0, false
+	}
+	if !withInterview {
+		return appID, 0, true
+	}
+	ivID, err = strconv.ParseInt(chi.URLParam(r, "interviewId"), 10, 64)
+	if err != nil || ivID <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid interview id")
+		return 0, 0, false
+	}
+	return appID, ivID, true
+}
+
+// @Summary      Schedule interview
+// @Description  Başvuruya mülakat turu ekler. İlk tur eklendiğinde, pipeline izin veriyorsa
+// @Description  başvuru status'u otomatik olarak interview'a geçer.
+// @Tags         interviews
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int64         true  "application id"
+// @Param        body  body  InterviewReq  true  "interview payload"
+// @Success      201   {object}  repo.Interview
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Router       /v1/applications/{id}/interviews [post]
+func (h *ApplicationsHandler) createInterview(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, _, ok := interviewPathIDs(w, r, false)
+	if !ok {
+		return
+	}
+
+	var req InterviewReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	f, err := req.validate()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	app, err := qtx.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{ID: appID, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	prior, err := qtx.CountInterviewsByApplication(ctx, appID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	iv, err := qtx.CreateInterview(ctx, repo.CreateInterviewParams{
+		ApplicationID: appID,
+		RoundName:     f.RoundName,
+		Type:          f.Type,
+		StartsAt:      f.StartsAt,
+		EndsAt:        f.EndsAt,
+		Timezone:      f.Timezone,
+		Location:      f.Location,
+		MeetingUrl:    f.MeetingURL,
+		Interviewers:  f.Interviewers,
+		Outcome:       f.Outcome,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventInterviewScheduled, map[string]any{
+		"application_id": appID,
+		"interview_id":   iv.ID,
+		"round_name":     iv.RoundName,
+		"type":           iv.Type,
+		"starts_at":      iv.StartsAt,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	// ilk (iptal edilmemiş) tur: pipeline izin veriyorsa status -> interview
+	if prior == 0 && iv.Outcome != "cancelled" {
+		if err := autoAdvanceStatus(ctx, qtx, uid, app, "interview", "first interview scheduled: "+iv.RoundName); err != nil {
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, localizeInterview(iv))
+}
+
+// @Summary      List interviews
+// @Tags         interviews
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  int64  true  "application id"
+// @Success      200  {object}  map[string]any
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/interviews [get]
+func (h *ApplicationsHandler) listInterviews(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, _, ok := interviewPathIDs(w, r, false)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if _, err := h.q.GetApplicationByID(ctx, repo.GetApplicationByIDParams{ID: appID, UserID: uid}); err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	rows, err := h.q.ListInterviewsByApplication(ctx, appID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	items := make([]repo.Interview, 0, len(rows))
+	for _, iv := range rows {
+		items = append(items, localizeInterview(iv))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": items})
+}
+
+// @Summary      Get interview
+// @Tags         interviews
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id           path  int64  true  "application id"
+// @Param        interviewId  path  int64  true  "interview id"
+// @Success      200  {object}  repo.Interview
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/interviews/{interviewId} [get]
+func (h *ApplicationsHandler) getInterview(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, ivID, ok := interviewPathIDs(w, r, true)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if _, err := h.q.GetApplicationByID(ctx, repo.GetApplicationByIDParams{ID: appID, UserID: uid}); err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	iv, err := h.q.GetInterview(ctx, repo.GetInterviewParams{ID: ivID, ApplicationID: appID})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "interview not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, localizeInterview(iv))
+}
+
+// @Summary      Patch interview
+// @Description  JSON Merge Patch; outcome ile sonuç (passed/failed/cancelled) işaretlenir
+// @Tags         interviews
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id           path  int64         true  "application id"
+// @Param        interviewId  path  int64         true  "interview id"
+// @Param        body         body  InterviewReq  true  "merge patch"
+// @Success      200  {object}  repo.Interview
+// @Failure      400  {object}  map[string]string
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/interviews/{interviewId} [patch]
+func (h *ApplicationsHandler) patchInterview(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, ivID, ok := interviewPathIDs(w, r, true)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	if _, err := qtx.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{ID: appID, UserID: uid}); err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	cur, err := qtx.GetInterview(ctx, repo.GetInterviewParams{ID: ivID, ApplicationID: appID})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "interview not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	req := interviewReqFrom(cur)
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	f, err := req.validate()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	iv, err := qtx.UpdateInterview(ctx, repo.UpdateInterviewParams{
+		RoundName:     f.RoundName,
+		Type:          f.Type,
+		StartsAt:      f.StartsAt,
+		EndsAt:        f.EndsAt,
+		Timezone:      f.Timezone,
+		Location:      f.Location,
+		MeetingUrl:    f.MeetingURL,
+		Interviewers:  f.Interviewers,
+		Outcome:       f.Outcome,
+		ID:            ivID,
+		ApplicationID: appID,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventInterviewUpdated, map[string]any{
+		"application_id": appID,
+		"interview_id":   iv.ID,
+		"old_outcome":    cur.Outcome,
+		"outcome":        iv.Outcome,
+		"starts_at":      iv.StartsAt,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, localizeInterview(iv))
+}
+
+// @Summary      Delete interview
+// @Tags         interviews
+// @Security     BearerAuth
+// @Param        id           path  int64  true  "application id"
+// @Param        interviewId  path  int64  true  "interview id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/interviews/{interviewId} [delete]
+func (h *ApplicationsHandler) deleteInterview(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, ivID, ok := interviewPathIDs(w, r, true)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	if _, err := qtx.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{ID: appID, UserID: uid}); err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	n, err := qtx.DeleteInterview(ctx, repo.DeleteInterviewParams{ID: ivID, ApplicationID: appID})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 {
+		writeError(w, http.StatusNotFound, "interview not found")
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventInterviewDeleted, map[string]any{
+		"application_id": appID,
+		"interview_id":   ivID,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,118 @@
+package httpx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+const (
+	recommendDefaultLimit = 20
+	recommendMaxLimit     = 50
+)
+
+// recommendationReason: skora katkı yapan tek sinyal
+type recommendationReason struct {
+	Type         string  `json:"type"`         // tag | company
+	Value        string  `json:"value"`        // tag adı (küçük harf) veya şirket
+	Weight       float64 `json:"weight"`       // skora katkı: eşleşen başvuruların aşama ağırlıkları toplamı
+	Applications int64   `json:"applications"` // bu tag / şirketle geçmiş başvuru sayısı
+	BestStatus   string  `json:"best_status"`  // bu başvuruların ulaştığı en ileri aşama
+}
+
+type recommendationView struct {
+	Job         repo.Job               `json:"job"`
+	Score       float64                `json:"score"`
+	Reasons     []recommendationReason `json:"reasons"`
+	Explanation string                 `json:"explanation"`
+}
+
+func recommendationFrom(row repo.ListRecommendedJobsRow) recommendationView {
+	v := recommendationView{Job: row.Job, Score: row.Score, Reasons: []recommendationReason{}}
+	var tagParts []string
+	for i, tag := range row.MatchedTags {
+		reason := recommendationReason{Type: "tag", Value: tag}
+		if i < len(row.TagWeights) {
+			reason.Weight = row.TagWeights[i]
+		}
+		if i < len(row.TagApplications) {
+			reason.Applications = row.TagApplications[i]
+		}
+		if i < len(row.TagBestStatuses) {
+			reason.BestStatus = row.TagBestStatuses[i]
+		}
+		v.Reasons = append(v.Reasons, reason)
+		tagParts = append(tagParts, fmt.Sprintf("%s (%d başvuru, en ileri: %s)", tag, reason.Applications, reason.BestStatus))
+	}
+
+	var parts []string
+	if row.CompanyApplications > 0 {
+		best := ""
+		if row.CompanyBestStatus != nil {
+			best = *row.CompanyBestStatus
+		}
+		// şirket sinyali tag'lerden önce: daha doğrudan bir gerekçe
+		v.Reasons = append([]recommendationReason{{
+			Type:         "company",
+			Value:        row.Job.Company,
+			Weight:       row.CompanyWeight,
+			Applications: row.CompanyApplications,
+			BestStatus:   best,
+		}}, v.Reasons...)
+		parts = append(parts, fmt.Sprintf("%s şirketine %d başvurunuz var (en ileri: %s)", row.Job.Company, row.CompanyApplications, best))
+	}
+	if len(tagParts) > 0 {
+		parts = append(parts, "başvurularınızla ortak etiketler: "+strings.Join(tagParts, ", "))
+	}
+	v.Explanation = strings.Join(parts, "; ")
+	return v
+}
+
+// @Summary      Recommended jobs
+// @Description  Geçmiş başvurularınızın tag ve şirketleriyle örtüşen görünür ilanlar (org ilanları ve kendi ilanlarınız).
+// @Description  Her başvuru ulaştığı en ileri aşama kadar ağırlık taşır (saved=1, applied=2, screening=3, ...;
+// @Description  rejected / withdrawn / ghosted ilerleme sayılmaz). Skor: eşleşen tag ağırlıkları + şirket ağırlığı.
+// @Description  Zaten başvuru kaydınız olan ilanlar listelenmez. Her öneri reasons ve explanation ile gerekçelendirilir.
+// @Tags         jobs
+// @Security     BearerAuth
+// @Produce      json
+// @Param        limit  query     int  false  "limit (1-50, default 20)"
+// @Success      200    {object}  map[string]any
+// @Router       /v1/jobs/recommended [get]
+func (h *JobsHandler) recommendedJobs(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	limit := int32(recommendDefaultLimit)
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 && n <= recommendMaxLimit {
+			limit = int32(n)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	rows, err := h.q.ListRecommendedJobs(ctx, repo.ListRecommendedJobsParams{
+		UserID:       uid,
+		ExitStatuses: statsExitStatuses,
+		Limit:        limit,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	items := make([]recommendationView, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, recommendationFrom(row))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": items, "limit": limit})
+}
@@ -0,0 +1,185 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+// revizyonda takip edilen ilan alanları
+var jobRevisionFields = []string{"org_id", "title", "company", "url", "location", "tags"}
+
+type jobFieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+func jobFieldValues(j repo.Job) map[string]any {
+	tags := j.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return map[string]any{
+		"org_id":   j.OrgID,
+		"title":    j.Title,
+		"company":  j.Company,
+		"url":      j.Url,
+		"location": j.Location,
+		"tags":     tags,
+	}
+}
+
+// diffJob: değişen alanları before/after olarak döner. before nil ise (yeni ilan)
+// her alan before=null ile yazılır.
+func diffJob(before *repo.Job, after repo.Job) map[string]jobFieldChange {
+	a := jobFieldValues(after)
+	changes := map[string]jobFieldChange{}
+	if before == nil {
+		for _, f := range jobRevisionFields {
+			changes[f] = jobFieldChange{Before: nil, After: a[f]}
+		}
+		return changes
+	}
+	b := jobFieldValues(*before)
+	for _, f := range jobRevisionFields {
+		if !reflect.DeepEqual(b[f], a[f]) {
+			changes[f] = jobFieldChange{Before: b[f], After: a[f]}
+		}
+	}
+	return changes
+}
+
+// recordJobRevision: değişiklik varsa yeni revizyon satırı yazar (çağıran transaction içinde).
+func recordJobRevision(ctx context.Context, q *repo.Queries, before *repo.Job, after repo.Job, uid int64) error {
+	changes := diffJob(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+	payload, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	_, err = q.CreateJobRevision(ctx, repo.CreateJobRevisionParams{
+		JobID:   after.ID,
+		UserID:  &uid,
+		Changes: payload,
+	})
+	return err
+}
+
+// @Summary List job revisions
+// @Description Sadece görünür ilanlar (kendi ilanlarınız + org ilanları); diğerleri 404
+// @Tags jobs
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Job ID"
+// @Success 200 {object} map[string]any
+// @Failure 404 {object} map[string]string
+// @Router /v1/jobs/{id}/revisions [get]
+func (h *JobsHandler) listRevisions(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if _, ok := loadVisibleJob(ctx, w, h.q, id, uid); !ok {
+		return
+	}
+
+	revs, err := h.q.ListJobRevisions(ctx, id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if revs == nil {
+		revs = []repo.JobRevision{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": revs})
+}
+
+type revisionFieldDiff struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+// @Summary Job revision diff
+// @Description Revizyonda değişen alanların önceki/sonraki değerleri (sadece görünür ilanlar, diğerleri 404)
+// @Tags jobs
+// @Security BearerAuth
+// @Produce json
+// @Param id  path int true "Job ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} map[string]any
+// @Failure 404 {object} map[string]string
+// @Router /v1/jobs/{id}/revisions/{rev}/diff [get]
+func (h *JobsHandler) revisionDiff(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+	rev, err := strconv.ParseInt(chi.URLParam(r, "rev"), 10, 32)
+	if err != nil || rev <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid rev")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if _, ok := loadVisibleJob(ctx, w, h.q, id, uid); !ok {
+		return
+	}
+	jr, err := h.q.GetJobRevision(ctx, repo.GetJobRevisionParams{JobID: id, Rev: int32(rev)})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "revision not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var changes map[string]jobFieldChange
+	if err := json.Unmarshal(jr.Changes, &changes); err != nil {
+		writeError(w, http.StatusInternalServerError, "corrupt revision")
+		return
+	}
+	fields := make([]revisionFieldDiff, 0, len(changes))
+	for _, f := range jobRevisionFields {
+		if c, ok := changes[f]; ok {
+			fields = append(fields, revisionFieldDiff{Field: f, Before: c.Before, After: c.After})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"job_id":     jr.JobID,
+		"rev":        jr.Rev,
+		"user_id":    jr.UserID,
+		"created_at": jr.CreatedAt,
+		"fields":     fields,
+	})
+}
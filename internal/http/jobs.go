@@ -3,8 +3,10 @@ package httpx
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -14,21 +16,31 @@ import (
 )
 
 type JobsHandler struct {
-	q *repo.Queries
+	q    *repo.Queries
+	pool *pgxpool.Pool
 }
 
 func NewJobsHandler(pool *pgxpool.Pool) *JobsHandler {
-	return &JobsHandler{q: repo.New(pool)}
+	return &JobsHandler{q: repo.New(pool), pool: pool}
 }
 
+// company + title benzerliği bu eşiğin üstündeyse ilan muhtemel kopya sayılır
+// pg_trgm'in varsayılan % eşiğinden (0.3) düşük olmamalı, bkz. FindSimilarJob
+const duplicateJobThreshold = 0.6
+
 func (h *JobsHandler) Router() http.Handler {
 	r := newSubrouter()
 
 	r.Post("/", h.createJob)
 	r.Get("/", h.listJobs)
+	r.Get("/recommended", h.recommendedJobs)
 	r.Get("/{id}", h.getJob)
-	r.Put("/{id}", h.updateJob)
+	r.Put("/{id}", h.replaceJob)
+	r.Patch("/{id}", h.patchJob)
 	r.Delete("/{id}", h.deleteJob)
+	r.Post("/{id}:merge", h.mergeJob)
+	r.Get("/{id}/revisions", h.listRevisions)
+	r.Get("/{id}/revisions/{rev}/diff", h.revisionDiff)
 
 	return r
 }
@@ -43,13 +55,16 @@ type CreateJobReq struct {
 }
 
 // @Summary Create job
+// @Description Aynı URL'ye ya da çok benzer company+title'a sahip bir ilan varsa 409 ve aday ilanı döner
 // @Tags jobs
 // @Security BearerAuth
 // @Accept json
 // @Produce json
-// @Param body body CreateJobReq true "job payload"
+// @Param body  body  CreateJobReq true  "job payload"
+// @Param force query bool         false "benzerlik kontrolünü atla"
 // @Success 201 {object} repo.Job
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]any
 // @Router /v1/jobs [post]
 func (h *JobsHandler) createJob(w http.ResponseWriter, r *http.Request) {
 	// Kullanıcı ID'sini context'ten al
@@ -77,6 +92,17 @@ func (h *JobsHandler) createJob(w http.ResponseWriter, r *http.Request) {
 		req.Tags = []string{}
 	}
 
+	// URL'yi normalize et (utm_* ve fragment temizlenir)
+	var canonical *string
+	if req.URL != nil && *req.URL != "" {
+		c, err := canonicalURL(*req.URL)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid url")
+			return
+		}
+		canonical = &c
+	}
+
 	// Timeout ile context oluştur
 	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
 	defer cancel()
@@ -93,37 +119,121 @@ func (h *JobsHandler) createJob(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Aynı URL daha önce eklenmiş mi?
+	if canonical != nil {
+		dup, err := h.q.GetJobByOwnerCanonicalURL(ctx, repo.GetJobByOwnerCanonicalURLParams{
+			OwnerID:      uid,
+			CanonicalUrl: *canonical,
+		})
+		if err == nil {
+			writeJSON(w, http.StatusConflict, map[string]any{
+				"error":     "job with same url already exists",
+				"candidate": dup,
+			})
+			return
+		}
+		if !isNoRows(err) {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	// Benzer company + title var mı? (?force=true ile atlanabilir)
+	if r.URL.Query().Get("force") != "true" {
+		cand, err := h.q.FindSimilarJob(ctx, repo.FindSimilarJobParams{
+			Company:   req.Company,
+			Title:     req.Title,
+			OwnerID:   uid,
+			Threshold: duplicateJobThreshold,
+		})
+		if err == nil {
+			writeJSON(w, http.StatusConflict, map[string]any{
+				"error":     "possible duplicate job",
+				"candidate": cand.Job,
+				"score":     cand.Score,
+			})
+			return
+		}
+		if !isNoRows(err) {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
 	// Yeni iş ilanı oluştur
-	job, err := h.q.CreateJob(ctx, repo.CreateJobParams{
-		OrgID:    req.OrgID,
-		Title:    req.Title,
-		Company:  req.Company,
-		Url:      req.URL,
-		Location: req.Location,
-		Tags:     req.Tags,
+	job, err := qtx.CreateJob(ctx, repo.CreateJobParams{
+		OrgID:        req.OrgID,
+		OwnerID:      &uid,
+		Title:        req.Title,
+		Company:      req.Company,
+		Url:          req.URL,
+		CanonicalUrl: canonical,
+		Location:     req.Location,
+		Tags:         req.Tags,
 	})
 	if err != nil {
+		if isUniqueViolation(err) {
+			writeError(w, http.StatusConflict, "job with same url already exists")
+			return
+		}
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
+	// İlk revizyon (tüm alanlar)
+	if err := recordJobRevision(ctx, qtx, nil, job, uid); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("ETag", jobETag(job))
 	writeJSON(w, http.StatusCreated, job)
 }
 
+// jobView: list yanıtı; labels isteyen kullanıcının etiketleri
+type jobView struct {
+	repo.Job
+	Labels []repo.Label `json:"labels"`
+}
+
 // @Summary List jobs
 // @Tags jobs
 // @Security BearerAuth
 // @Produce json
 // @Param company query string false "filter by company (ILIKE)"
 // @Param title   query string false "filter by title (ILIKE)"
+// @Param label_id query string false "kendi etiket id'lerin; virgülle birden fazla (herhangi biri)"
 // @Param limit   query int    false "limit (default 20)"
 // @Param offset  query int    false "offset (default 0)"
 // @Success 200 {object} map[string]any
 // @Router /v1/jobs [get]
 func (h *JobsHandler) listJobs(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
 	q := r.URL.Query()
 	company := q.Get("company")
 	title := q.Get("title")
+	labelIDs, err := queryIDs(q, "label_id")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	limit := int32(20)
 	offset := int32(0)
@@ -150,16 +260,35 @@ func (h *JobsHandler) listJobs(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
 	defer cancel()
 
-	items, err := h.q.ListJobs(ctx, repo.ListJobsParams{
-		Company: companyPtr,
-		Title:   titlePtr,
-		Limit:   limit,
-		Offset:  offset,
+	jobs, err := h.q.ListJobs(ctx, repo.ListJobsParams{
+		Company:  companyPtr,
+		Title:    titlePtr,
+		LabelIds: labelIDs,
+		UserID:   uid,
+		Limit:    limit,
+		Offset:   offset,
 	})
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	jobIDs := make([]int64, 0, len(jobs))
+	for _, j := range jobs {
+		jobIDs = append(jobIDs, j.ID)
+	}
+	labels, err := jobLabels(ctx, h.q, uid, jobIDs)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	items := make([]jobView, 0, len(jobs))
+	for _, j := range jobs {
+		v := jobView{Job: j, Labels: labels[j.ID]}
+		if v.Labels == nil {
+			v.Labels = []repo.Label{}
+		}
+		items = append(items, v)
+	}
 
 	writeJSON(w, http.StatusOK, map[string]any{
 		"items":  items,
@@ -169,11 +298,14 @@ func (h *JobsHandler) listJobs(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Get job by ID
+// @Description Yanıt ETag başlığı taşır; If-None-Match eşleşirse 304 döner
 // @Tags jobs
 // @Security BearerAuth
 // @Produce json
-// @Param id path int true "Job ID"
+// @Param id            path   int    true  "Job ID"
+// @Param If-None-Match header string false "önceki ETag"
 // @Success 200 {object} repo.Job
+// @Success 304 "Not Modified"
 // @Failure 404 {object} map[string]string
 // @Router /v1/jobs/{id} [get]
 func (h *JobsHandler) getJob(w http.ResponseWriter, r *http.Request) {
@@ -193,27 +325,40 @@ func (h *JobsHandler) getJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	etag := jobETag(job)
+	w.Header().Set("ETag", etag)
+	if inm := r.Header.Get("If-None-Match"); inm != "" && etagMatches(inm, etag, true) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
 	writeJSON(w, http.StatusOK, job)
 }
 
-type UpdateJobReq struct {
-	Title    *string   `json:"title,omitempty"`
-	Company  *string   `json:"company,omitempty"`
-	URL      *string   `json:"url,omitempty"`
-	Location *string   `json:"location,omitempty"`
-	Tags     *[]string `json:"tags,omitempty"`
+type ReplaceJobReq struct {
+	Title    string   `json:"title"`
+	Company  string   `json:"company"`
+	URL      *string  `json:"url"`      // verilmezse NULL olur
+	Location *string  `json:"location"` // verilmezse NULL olur
+	Tags     []string `json:"tags"`     // verilmezse []
 }
 
-// @Summary Update job
+// @Summary Replace job
+// @Description Tam değiştirme: verilmeyen opsiyonel alanlar temizlenir. If-Match verilirse güncel ETag ile eşleşmeli (412); org ilanlarında zorunlu (428)
 // @Tags jobs
 // @Security BearerAuth
 // @Accept json
 // @Produce json
-// @Param id path int true "Job ID"
-// @Param body body UpdateJobReq true "job payload"
+// @Param id       path   int           true  "Job ID"
+// @Param If-Match header string        false "GET ile alınan ETag"
+// @Param body     body   ReplaceJobReq true  "job payload"
 // @Success 200 {object} repo.Job
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 412 {object} map[string]string
+// @Failure 428 {object} map[string]string
 // @Router /v1/jobs/{id} [put]
-func (h *JobsHandler) updateJob(w http.ResponseWriter, r *http.Request) {
+func (h *JobsHandler) replaceJob(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
@@ -221,49 +366,219 @@ func (h *JobsHandler) updateJob(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req UpdateJobReq
+	var req ReplaceJobReq
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeError(w, http.StatusBadRequest, "invalid json")
 		return
 	}
-
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
-	defer cancel()
-
-	params := repo.UpdateJobParams{
-		ID: id,
+	if req.Title == "" || req.Company == "" {
+		writeError(w, http.StatusBadRequest, "title and company required")
+		return
 	}
-	if req.Title != nil {
-		params.Title = req.Title
+	if req.Tags == nil {
+		req.Tags = []string{}
 	}
-	if req.Company != nil {
-		params.Company = req.Company
+
+	params := repo.UpdateJobParams{
+		ID:          id,
+		SetTitle:    true,
+		Title:       req.Title,
+		SetCompany:  true,
+		Company:     req.Company,
+		SetUrl:      true,
+		SetLocation: true,
+		Location:    req.Location,
+		SetTags:     true,
+		Tags:        req.Tags,
 	}
 	if req.URL != nil {
+		c, err := canonicalURL(*req.URL)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid url")
+			return
+		}
 		params.Url = req.URL
+		params.CanonicalUrl = &c
 	}
-	if req.Location != nil {
-		params.Location = req.Location
+
+	h.saveJobUpdate(w, r, params)
+}
+
+// @Summary Patch job
+// @Description RFC 7396 JSON Merge Patch: verilmeyen alan değişmez, null alanı temizler (url, location, tags).
+// @Description If-Match verilirse güncel ETag ile eşleşmeli (412); org ilanlarında zorunlu (428)
+// @Tags jobs
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id       path   int            true  "Job ID"
+// @Param If-Match header string         false "GET ile alınan ETag"
+// @Param body     body   map[string]any true  "merge patch (title, company, url, location, tags)"
+// @Success 200 {object} repo.Job
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 412 {object} map[string]string
+// @Failure 415 {object} map[string]string
+// @Failure 428 {object} map[string]string
+// @Router /v1/jobs/{id} [patch]
+func (h *JobsHandler) patchJob(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
 	}
-	if req.Tags != nil {
-		params.Tags = *req.Tags
+
+	ct := r.Header.Get("Content-Type")
+	if ct != "" && !strings.HasPrefix(ct, "application/merge-patch+json") && !strings.HasPrefix(ct, "application/json") {
+		writeError(w, http.StatusUnsupportedMediaType, "use application/merge-patch+json")
+		return
 	}
 
-	job, err := h.q.UpdateJob(ctx, params)
+	// alan yok / null / değer ayrımı için ham JSON
+	var patch map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil || patch == nil {
+		writeError(w, http.StatusBadRequest, "invalid json: merge patch must be an object")
+		return
+	}
+
+	params, err := jobMergePatchParams(id, patch)
 	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	h.saveJobUpdate(w, r, params)
+}
+
+// jobMergePatchParams: merge patch dokümanını UpdateJob parametrelerine çevirir.
+func jobMergePatchParams(id int64, patch map[string]json.RawMessage) (repo.UpdateJobParams, error) {
+	params := repo.UpdateJobParams{ID: id}
+	isNull := func(raw json.RawMessage) bool { return string(raw) == "null" }
+
+	for field, raw := range patch {
+		switch field {
+		case "title", "company":
+			var v string
+			if isNull(raw) || json.Unmarshal(raw, &v) != nil || v == "" {
+				return params, errors.New(field + " must be a non-empty string")
+			}
+			if field == "title" {
+				params.SetTitle, params.Title = true, v
+			} else {
+				params.SetCompany, params.Company = true, v
+			}
+		case "url":
+			params.SetUrl = true
+			if isNull(raw) {
+				continue
+			}
+			var v string
+			if json.Unmarshal(raw, &v) != nil {
+				return params, errors.New("url must be a string or null")
+			}
+			c, err := canonicalURL(v)
+			if err != nil {
+				return params, errors.New("invalid url")
+			}
+			params.Url, params.CanonicalUrl = &v, &c
+		case "location":
+			params.SetLocation = true
+			if isNull(raw) {
+				continue
+			}
+			var v string
+			if json.Unmarshal(raw, &v) != nil {
+				return params, errors.New("location must be a string or null")
+			}
+			params.Location = &v
+		case "tags":
+			params.SetTags = true
+			params.Tags = []string{}
+			if isNull(raw) {
+				continue
+			}
+			if json.Unmarshal(raw, &params.Tags) != nil {
+				return params, errors.New("tags must be an array of strings or null")
+			}
+		default:
+			return params, errors.New("unknown or read-only field: " + field)
+		}
+	}
+	return params, nil
+}
+
+// saveJobUpdate: PUT/PATCH ortak yolu. Satırı kilitler, yetkiyi ve If-Match'i kontrol eder,
+// günceller ve revizyon yazar.
+func (h *JobsHandler) saveJobUpdate(w http.ResponseWriter, r *http.Request, params repo.UpdateJobParams) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	// revizyon farkı için eski hali (satır kilitli); sahip / org owner-admin değilse 403
+	before, ok := loadOwnedJob(ctx, w, qtx, params.ID, uid)
+	if !ok {
+		return
+	}
+	if !checkJobIfMatch(w, r, before) {
+		return
+	}
+
+	job, err := qtx.UpdateJob(ctx, params)
+	if err != nil {
+		if isUniqueViolation(err) {
+			writeError(w, http.StatusConflict, "job with same url already exists")
+			return
+		}
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	if err := recordJobRevision(ctx, qtx, &before, job, uid); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("ETag", jobETag(job))
 	writeJSON(w, http.StatusOK, job)
 }
 
 // @Summary Delete job
+// @Description Sadece ilan sahibi (org ilanında org owner/admin) silebilir. Başka kullanıcıların başvurusu varsa 409.
+// @Description If-Match verilirse güncel ETag ile eşleşmeli (412); org ilanlarında zorunlu (428)
 // @Tags jobs
 // @Security BearerAuth
-// @Param id path int true "Job ID"
+// @Param id       path   int    true  "Job ID"
+// @Param If-Match header string false "GET ile alınan ETag"
 // @Success 204 "No Content"
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 412 {object} map[string]string
+// @Failure 428 {object} map[string]string
 // @Router /v1/jobs/{id} [delete]
 func (h *JobsHandler) deleteJob(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
@@ -274,9 +589,299 @@ func (h *JobsHandler) deleteJob(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
 	defer cancel()
 
-	if err := h.q.DeleteJob(ctx, id); err != nil {
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	job, ok := loadOwnedJob(ctx, w, qtx, id, uid)
+	if !ok {
+		return
+	}
+	if !checkJobIfMatch(w, r, job) {
+		return
+	}
+	// başvurular ilanla birlikte silinir (ON DELETE CASCADE): başkalarının verisi silinmesin
+	others, err := qtx.CountOtherUsersJobApplications(ctx, repo.CountOtherUsersJobApplicationsParams{
+		JobID:  id,
+		UserID: uid,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if others > 0 {
+		writeError(w, http.StatusConflict, "job has applications from other users")
+		return
+	}
+
+	if err := qtx.DeleteJob(ctx, id); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
+
+type MergeJobReq struct {
+	SourceID int64 `json:"source_id"` // birleştirilip silinecek ilan
+}
+
+// @Summary Merge duplicate job
+// @Description source_id ilanındaki kendi aktif başvurularınızı {id} ilanına taşır, tag'leri birleştirir, etiket ve kişi bağlantılarını kopyalar ve source ilanı siler.
+// @Description source ilanında başka kullanıcıların başvuruları varsa 409; silinmiş kendi başvurularınız ilanla birlikte silinir
+// @Description If-Match verilirse iki ilanın güncel ETag'lerini de içermeli (virgülle, 412); org ilanlarında zorunlu (428)
+// @Tags jobs
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id       path   int         true  "Surviving job ID"
+// @Param If-Match header string      false "iki ilanın ETag'leri"
+// @Param body     body   MergeJobReq true  "merge payload"
+// @Success 200 {object} map[string]any
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 412 {object} map[string]string
+// @Failure 428 {object} map[string]string
+// @Router /v1/jobs/{id}:merge [post]
+func (h *JobsHandler) mergeJob(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req MergeJobReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	if req.SourceID <= 0 || req.SourceID == id {
+		writeError(w, http.StatusBadRequest, "invalid source_id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	// iki satır da kilitlenir; eşzamanlı birleştirmelerde deadlock olmasın diye id sırasıyla
+	var target, source repo.Job
+	first, second := &target, &source
+	firstID, secondID := id, req.SourceID
+	if req.SourceID < id {
+		first, second = second, first
+		firstID, secondID = secondID, firstID
+	}
+	if *first, ok = loadOwnedJob(ctx, w, qtx, firstID, uid); !ok {
+		return
+	}
+	if *second, ok = loadOwnedJob(ctx, w, qtx, secondID, uid); !ok {
+		return
+	}
+
+	// iki ilan da değişir: If-Match (varsa) iki ETag'i de içermeli, org ilanlarında zorunlu
+	if !checkJobIfMatch(w, r, target) || !checkJobIfMatch(w, r, source) {
+		return
+	}
+
+	// ilan sahibi başkalarının başvurularının sahibi değil: onları taşımak / silmek yerine reddet
+	others, err := qtx.CountOtherUsersJobApplications(ctx, repo.CountOtherUsersJobApplicationsParams{
+		JobID:  source.ID,
+		UserID: uid,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if others > 0 {
+		writeError(w, http.StatusConflict, "source job has applications from other users")
+		return
+	}
+
+	// 1) başvuruları hayatta kalan ilana taşı; iki ilana da başvurduysanız
+	// kopyadaki başvurunuz soft delete edilir (user_id, job_id unique)
+	dropped, err := qtx.SoftDeleteDuplicateApplications(ctx, repo.SoftDeleteDuplicateApplicationsParams{
+		SourceID: source.ID,
+		UserID:   uid,
+		TargetID: target.ID,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for _, appID := range dropped {
+		if err := recordEvent(ctx, qtx, uid, &appID, eventApplicationDeleted, map[string]any{
+			"application_id": appID,
+			"merge":          true,
+			"kept_job_id":    target.ID,
+		}); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	moved, err := qtx.MoveApplicationsToJob(ctx, repo.MoveApplicationsToJobParams{
+		TargetID: target.ID,
+		SourceID: source.ID,
+		UserID:   uid,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for _, appID := range moved {
+		if err := recordEvent(ctx, qtx, uid, &appID, eventApplicationUpdated, map[string]any{
+			"application_id": appID,
+			"fields":         []string{"job_id"},
+			"old_job_id":     source.ID,
+			"job_id":         target.ID,
+			"merge":          true,
+		}); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	if _, err := qtx.CopyJobLabels(ctx, repo.CopyJobLabelsParams{
+		TargetID: target.ID,
+		SourceID: source.ID,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if _, err := qtx.CopyContactJobs(ctx, repo.CopyContactJobsParams{
+		TargetID: target.ID,
+		SourceID: source.ID,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 2) kopyayı sil (canonical_url unique index'i serbest kalsın diye update'ten önce)
+	if err := qtx.DeleteJob(ctx, source.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 3) eksik alanları kopyadan tamamla, tag'leri birleştir
+	params := repo.UpdateJobParams{
+		ID:      target.ID,
+		SetTags: true,
+		Tags:    mergeTags(target.Tags, source.Tags),
+	}
+	if target.Url == nil {
+		params.SetUrl = true
+		params.Url = source.Url
+		params.CanonicalUrl = source.CanonicalUrl
+	}
+	if target.Location == nil {
+		params.SetLocation = true
+		params.Location = source.Location
+	}
+	job, err := qtx.UpdateJob(ctx, params)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := recordJobRevision(ctx, qtx, &target, job, uid); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"job":                job,
+		"merged_job_id":      source.ID,
+		"moved_applications": len(moved),
+		"deleted_duplicates": len(dropped),
+	})
+}
+
+// loadVisibleJob: ilanı çağıran görebiliyorsa getirir (job_visible_to); görünmeyen ilan
+// var olsa da 404 yazar, böylece başkasının kişisel ilanlarının varlığı sızmaz.
+func loadVisibleJob(ctx context.Context, w http.ResponseWriter, q *repo.Queries, id, uid int64) (repo.Job, bool) {
+	job, err := q.GetVisibleJob(ctx, repo.GetVisibleJobParams{ID: id, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "job not found")
+			return repo.Job{}, false
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return repo.Job{}, false
+	}
+	return job, true
+}
+
+// loadOwnedJob: ilanı kilitleyerek getirir (transaction içinde çağrılmalı);
+// yoksa 404, çağıran ilanı değiştiremiyorsa 403 yazar (bkz. authorizeJobWrite).
+func loadOwnedJob(ctx context.Context, w http.ResponseWriter, q *repo.Queries, id, uid int64) (repo.Job, bool) {
+	job, err := q.GetJobByIDForUpdate(ctx, id)
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "job not found")
+			return repo.Job{}, false
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return repo.Job{}, false
+	}
+	if !authorizeJobWrite(ctx, w, q, job, uid) {
+		return repo.Job{}, false
+	}
+	return job, true
+}
+
+// authorizeJobWrite: ilanı sahibi, org ilanıysa org'un owner/admin'leri de değiştirebilir (değilse 403).
+func authorizeJobWrite(ctx context.Context, w http.ResponseWriter, q *repo.Queries, job repo.Job, uid int64) bool {
+	if job.OwnerID != nil && *job.OwnerID == uid {
+		return true
+	}
+	if job.OrgID != nil {
+		return requireOrgRole(ctx, w, q, *job.OrgID, uid, "owner", "admin")
+	}
+	if job.OwnerID == nil {
+		// 20250902 backfill'inde sahip bulunamayan eski ilan (org'suz, başvurusuz)
+		writeError(w, http.StatusForbidden, "job has no owner")
+		return false
+	}
+	writeError(w, http.StatusForbidden, "forbidden")
+	return false
+}
+
+// mergeTags: sırayı koruyarak tekrarsız birleşim
+func mergeTags(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	out := make([]string, 0, len(a)+len(b))
+	for _, t := range append(append([]string{}, a...), b...) {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
@@ -0,0 +1,477 @@
+package httpx
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+const (
+	maxImportBytes = 10 << 20 // 10 MB
+	maxImportRows  = 5000
+	exportPageSize = 500
+)
+
+// importFields: içe aktarılabilen ilan alanları (mapping anahtarları)
+var importFields = []string{"title", "company", "url", "location", "tags"}
+
+type importRowError struct {
+	Row   int    `json:"row"` // CSV: 1'den başlayan veri satırı (başlık sayılmaz); NDJSON: dosyadaki satır no (boş satırlar dahil)
+	Error string `json:"error"`
+}
+
+// @Summary Bulk import jobs
+// @Description CSV (başlık satırı zorunlu) ya da NDJSON ilan listesini içe aktarır. Geçersiz satırlar atlanır ve raporlanır (row: CSV veri satırı, NDJSON dosya satırı).
+// @Description mapping: hedef alan -> kaynak kolon, ör. {"title":"Position","company":"Employer"}. tags CSV'de virgülle ayrılır.
+// @Tags jobs
+// @Security BearerAuth
+// @Accept plain
+// @Produce json
+// @Param format  query string false "csv|ndjson (varsayılan Content-Type'tan)"
+// @Param mapping query string false "JSON kolon eşlemesi"
+// @Success 200 {object} map[string]any
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 413 {object} map[string]string
+// @Router /v1/jobs:bulkImport [post]
+func (h *JobsHandler) BulkImport(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	q := r.URL.Query()
+	format := q.Get("format")
+	if format == "" {
+		ct := r.Header.Get("Content-Type")
+		switch {
+		case strings.HasPrefix(ct, "text/csv"):
+			format = "csv"
+		case strings.HasPrefix(ct, "application/x-ndjson"), strings.HasPrefix(ct, "application/jsonl"):
+			format = "ndjson"
+		}
+	}
+
+	mapping := make(map[string]string, len(importFields))
+	for _, f := range importFields {
+		mapping[f] = f
+	}
+	if m := q.Get("mapping"); m != "" {
+		var custom map[string]string
+		if err := json.Unmarshal([]byte(m), &custom); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid mapping")
+			return
+		}
+		for k, v := range custom {
+			if _, ok := mapping[k]; !ok {
+				writeError(w, http.StatusBadRequest, "unknown mapping field: "+k)
+				return
+			}
+			mapping[k] = v
+		}
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxImportBytes)
+	var (
+		records []map[string]string
+		lines   []int // records[i] için hatalarda raporlanan satır no
+		err     error
+	)
+	switch format {
+	case "csv":
+		records, err = readCSVRecords(body)
+		lines = make([]int, len(records))
+		for i := range lines {
+			lines[i] = i + 1
+		}
+	case "ndjson":
+		records, lines, err = readNDJSONRecords(body)
+	default:
+		writeError(w, http.StatusBadRequest, "format must be csv or ndjson")
+		return
+	}
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "payload too large")
+			return
+		}
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(records) > maxImportRows {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("max %d rows", maxImportRows))
+		return
+	}
+
+	// 1) satır satır doğrula
+	var (
+		rowErrs []importRowError
+		params  []repo.ImportJobsParams
+		rowNums []int // params[i] hangi satırdan geldi
+	)
+	seen := map[string]int{}
+	for i, rec := range records {
+		rowNum := lines[i]
+		if rec == nil {
+			rowErrs = append(rowErrs, importRowError{Row: rowNum, Error: "invalid json"})
+			continue
+		}
+		p, err := importRowToParams(uid, rec, mapping)
+		if err != nil {
+			rowErrs = append(rowErrs, importRowError{Row: rowNum, Error: err.Error()})
+			continue
+		}
+		if p.CanonicalUrl != nil {
+			if first, dup := seen[*p.CanonicalUrl]; dup {
+				rowErrs = append(rowErrs, importRowError{Row: rowNum, Error: fmt.Sprintf("duplicate url (row %d)", first)})
+				continue
+			}
+			seen[*p.CanonicalUrl] = rowNum
+		}
+		params = append(params, p)
+		rowNums = append(rowNums, rowNum)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	// 2) daha önce eklenmiş URL'leri ayıkla
+	if len(seen) > 0 {
+		urls := make([]string, 0, len(seen))
+		for u := range seen {
+			urls = append(urls, u)
+		}
+		existing, err := h.q.ListOwnerCanonicalURLs(ctx, repo.ListOwnerCanonicalURLsParams{
+			OwnerID: uid,
+			Urls:    urls,
+		})
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if len(existing) > 0 {
+			exists := make(map[string]bool, len(existing))
+			for _, u := range existing {
+				exists[u] = true
+			}
+			kept := params[:0]
+			keptRows := rowNums[:0]
+			for i, p := range params {
+				if p.CanonicalUrl != nil && exists[*p.CanonicalUrl] {
+					rowErrs = append(rowErrs, importRowError{Row: rowNums[i], Error: "job with same url already exists"})
+					continue
+				}
+				kept = append(kept, p)
+				keptRows = append(keptRows, rowNums[i])
+			}
+			params, rowNums = kept, keptRows
+		}
+	}
+
+	// 3) geçerli satırları tek transaction içinde COPY ile yaz
+	var inserted int64
+	if len(params) > 0 {
+		tx, err := h.pool.Begin(ctx)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer func() { _ = tx.Rollback(ctx) }()
+
+		qtx := h.q.WithTx(tx)
+		// COPY id döndürmez; revizyonların doğru ilanlara yazılması için id'ler önceden alınır
+		ids, err := qtx.ReserveJobIDs(ctx, int32(len(params)))
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		for i := range params {
+			params[i].ID = ids[i]
+		}
+		inserted, err = qtx.ImportJobs(ctx, params)
+		if err != nil {
+			if isUniqueViolation(err) {
+				writeError(w, http.StatusConflict, "job with same url already exists")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// eklenen ilanların ilk revizyonları
+		created, err := qtx.ListJobsByIDs(ctx, ids)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		revs := make([]repo.CreateJobRevisionsParams, 0, len(created))
+		for _, j := range created {
+			payload, err := json.Marshal(diffJob(nil, j))
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			revs = append(revs, repo.CreateJobRevisionsParams{
+				JobID:   j.ID,
+				Rev:     1,
+				UserID:  &uid,
+				Changes: payload,
+			})
+		}
+		if _, err := qtx.CreateJobRevisions(ctx, revs); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if err := tx.Commit(ctx); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	if rowErrs == nil {
+		rowErrs = []importRowError{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"total":    len(records),
+		"inserted": inserted,
+		"failed":   len(rowErrs),
+		"errors":   rowErrs,
+	})
+}
+
+// importRowToParams: eşlenmiş kaydı doğrular ve insert parametresine çevirir.
+func importRowToParams(uid int64, rec map[string]string, mapping map[string]string) (repo.ImportJobsParams, error) {
+	get := func(field string) string { return strings.TrimSpace(rec[mapping[field]]) }
+
+	p := repo.ImportJobsParams{
+		OwnerID: uid,
+		Title:   get("title"),
+		Company: get("company"),
+		Tags:    []string{},
+	}
+	if p.Title == "" || p.Company == "" {
+		return p, errors.New("title and company required")
+	}
+	if u := get("url"); u != "" {
+		c, err := canonicalURL(u)
+		if err != nil {
+			return p, errors.New("invalid url")
+		}
+		p.Url = &u
+		p.CanonicalUrl = &c
+	}
+	if l := get("location"); l != "" {
+		p.Location = &l
+	}
+	for _, t := range strings.Split(get("tags"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			p.Tags = append(p.Tags, t)
+		}
+	}
+	return p, nil
+}
+
+// readCSVRecords: ilk satırı başlık kabul eder, her satırı kolon adı -> değer map'ine çevirir.
+func readCSVRecords(r io.Reader) ([]map[string]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("empty csv")
+		}
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var out []map[string]string
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rec := make(map[string]string, len(header))
+		for i, col := range header {
+			if i < len(row) {
+				rec[col] = row[i]
+			}
+		}
+		out = append(out, rec)
+	}
+	return out, nil
+}
+
+// readNDJSONRecords: her satır bir JSON obje; string dışı değerler string'e,
+// string dizileri virgülle birleştirilir (tags). Parse edilemeyen satır nil döner.
+// lines[i], out[i]'nin dosyadaki satır numarasıdır (boş satırlar atlanır ama sayılır).
+func readNDJSONRecords(r io.Reader) (out []map[string]string, lines []int, err error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), maxImportBytes)
+
+	line := 0
+	for sc.Scan() {
+		line++
+		text := strings.TrimSpace(sc.Text())
+		if text == "" {
+			continue
+		}
+		lines = append(lines, line)
+		var raw map[string]any
+		if err := json.Unmarshal([]byte(text), &raw); err != nil {
+			out = append(out, nil) // satır hatası olarak raporlanır
+			continue
+		}
+		rec := make(map[string]string, len(raw))
+		for k, v := range raw {
+			switch t := v.(type) {
+			case nil:
+			case string:
+				rec[k] = t
+			case []any:
+				parts := make([]string, 0, len(t))
+				for _, e := range t {
+					parts = append(parts, fmt.Sprint(e))
+				}
+				rec[k] = strings.Join(parts, ",")
+			default:
+				rec[k] = fmt.Sprint(t)
+			}
+		}
+		out = append(out, rec)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, nil, err
+	}
+	return out, lines, nil
+}
+
+// @Summary Export jobs
+// @Description Kullanıcının görebildiği (kendi + üyesi olduğu org) ilanları CSV ya da NDJSON olarak stream eder (süre sınırı yok; sayfa sayfa flush edilir)
+// @Tags jobs
+// @Security BearerAuth
+// @Produce plain
+// @Param format query string false "csv|ndjson (default csv)"
+// @Success 200 {string} string
+// @Failure 400 {object} map[string]string
+// @Router /v1/jobs:export [get]
+func (h *JobsHandler) Export(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+
+	var (
+		writeRow func(repo.Job) error
+		flush    func() error
+	)
+	switch format {
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="jobs.csv"`)
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"id", "org_id", "title", "company", "url", "location", "tags", "created_at", "updated_at"}); err != nil {
+			return
+		}
+		writeRow = func(j repo.Job) error { return cw.Write(jobCSVRow(j)) }
+		flush = func() error { cw.Flush(); return cw.Error() }
+	case "ndjson":
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", `attachment; filename="jobs.ndjson"`)
+		enc := json.NewEncoder(w)
+		writeRow = func(j repo.Job) error { return enc.Encode(j) }
+		flush = func() error { return nil }
+	default:
+		writeError(w, http.StatusBadRequest, "format must be csv or ndjson")
+		return
+	}
+
+	// sayfa sayfa oku, her sayfadan sonra flush et. Sunucunun WriteTimeout'u (15s) büyük
+	// export'u 200 ile ortadan kesmesin diye bu istek için yazma süresi sınırı kaldırılır;
+	// her sayfa sorgusu yine kendi timeout'una sahip.
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+	var afterID int64
+	for {
+		ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+		items, err := h.q.ListManagedJobsAfter(ctx, repo.ListManagedJobsAfterParams{
+			AfterID: afterID,
+			UserID:  uid,
+			Limit:   exportPageSize,
+		})
+		cancel()
+		if err != nil {
+			if afterID == 0 {
+				w.Header().Del("Content-Disposition")
+				writeError(w, http.StatusInternalServerError, err.Error())
+			}
+			// sonraki sayfalarda header gönderilmiş oluyor; stream'i kesmekten başka çare yok
+			return
+		}
+		for _, j := range items {
+			if err := writeRow(j); err != nil {
+				return
+			}
+		}
+		if err := flush(); err != nil {
+			return
+		}
+		_ = rc.Flush()
+		if len(items) < exportPageSize {
+			return
+		}
+		afterID = items[len(items)-1].ID
+	}
+}
+
+func jobCSVRow(j repo.Job) []string {
+	str := func(p *string) string {
+		if p == nil {
+			return ""
+		}
+		return *p
+	}
+	orgID := ""
+	if j.OrgID != nil {
+		orgID = strconv.FormatInt(*j.OrgID, 10)
+	}
+	updatedAt := ""
+	if j.UpdatedAt != nil {
+		updatedAt = j.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		strconv.FormatInt(j.ID, 10),
+		orgID,
+		j.Title,
+		j.Company,
+		str(j.Url),
+		str(j.Location),
+		strings.Join(j.Tags, ","),
+		j.CreatedAt.UTC().Format(time.RFC3339),
+		updatedAt,
+	}
+}
@@ -0,0 +1,685 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+const (
+	labelMaxName      = 50
+	labelDefaultColor = "#9ca3af"
+)
+
+var labelColorRe = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// LabelsHandler: kullanıcının etiketleri; başvuru ve ilanlara n-n bağlanır
+type LabelsHandler struct {
+	q    *repo.Queries
+	pool *pgxpool.Pool
+}
+
+func NewLabelsHandler(pool *pgxpool.Pool) *LabelsHandler {
+	return &LabelsHandler{q: repo.New(pool), pool: pool}
+}
+
+func (h *LabelsHandler) Router() http.Handler {
+	r := chi.NewRouter()
+	r.Post("/", h.create)                                       // POST   /v1/labels
+	r.Get("/", h.list)                                          // GET    /v1/labels
+	r.Post("/{id}:merge", h.merge)                              // POST   /v1/labels/{id}:merge
+	r.Get("/{id}", h.get)                                       // GET    /v1/labels/{id}
+	r.Patch("/{id}", h.patch)                                   // PATCH  /v1/labels/{id}
+	r.Delete("/{id}", h.delete)                                 // DELETE /v1/labels/{id}
+	r.Put("/{id}/applications/{appId}", h.linkApplication)      // PUT    /v1/labels/{id}/applications/{appId}
+	r.Delete("/{id}/applications/{appId}", h.unlinkApplication) // DELETE /v1/labels/{id}/applications/{appId}
+	r.Put("/{id}/jobs/{jobId}", h.linkJob)                      // PUT    /v1/labels/{id}/jobs/{jobId}
+	r.Delete("/{id}/jobs/{jobId}", h.unlinkJob)                 // DELETE /v1/labels/{id}/jobs/{jobId}
+	return r
+}
+
+// LabelReq: create gövdesi; PATCH'te mevcut etiket bu yapıya doldurulup gövde üzerine decode edilir.
+type LabelReq struct {
+	Name  string `json:"name"`            // 1-50 karakter, kullanıcı içinde tekil (büyük/küçük harf duyarsız)
+	Color string `json:"color,omitempty"` // #rrggbb, default gri
+}
+
+func (req LabelReq) validate() (LabelReq, error) {
+	f := LabelReq{
+		Name:  strings.TrimSpace(req.Name),
+		Color: strings.ToLower(strings.TrimSpace(req.Color)),
+	}
+	if f.Name == "" || utf8.RuneCountInString(f.Name) > labelMaxName {
+		return f, errors.New("name required (max 50 chars)")
+	}
+	if f.Color == "" {
+		f.Color = labelDefaultColor
+	}
+	if !labelColorRe.MatchString(f.Color) {
+		return f, errors.New("invalid color (#rrggbb)")
+	}
+	return f, nil
+}
+
+type labelView struct {
+	repo.Label
+	ApplicationIDs []int64 `json:"application_ids"`
+	JobIDs         []int64 `json:"job_ids"`
+}
+
+// queryIDs: virgülle ayrılmış veya tekrarlanan pozitif id listesi (label_id=1,2&label_id=3)
+func queryIDs(q url.Values, name string) ([]int64, error) {
+	var ids []int64
+	for _, v := range q[name] {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s == "" {
+				continue
+			}
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil || id <= 0 {
+				return nil, errors.New("invalid " + name)
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// loadLabel: etiketin kullanıcıya ait olduğunu doğrular (değilse 404)
+func loadLabel(ctx context.Context, w http.ResponseWriter, q *repo.Queries, id, uid int64) (repo.Label, bool) {
+	l, err := q.GetLabel(ctx, repo.GetLabelParams{ID: id, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "label not found")
+			return l, false
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return l, false
+	}
+	return l, true
+}
+
+// setApplicationLabel: etiketi başvuruya ekler / çıkarır, değiştiyse event yazar.
+// Etiket ve başvurunun sahipliğini çağıran doğrulamış olmalı.
+func setApplicationLabel(ctx context.Context, q *repo.Queries, uid, appID int64, l repo.Label, link bool) (bool, error) {
+	var (
+		n   int64
+		err error
+		typ = eventApplicationLabelAdded
+	)
+	if link {
+		n, err = q.LinkLabelApplication(ctx, repo.LinkLabelApplicationParams{LabelID: l.ID, ApplicationID: appID})
+	} else {
+		typ = eventApplicationLabelRemoved
+		n, err = q.UnlinkLabelApplication(ctx, repo.UnlinkLabelApplicationParams{LabelID: l.ID, ApplicationID: appID})
+	}
+	if err != nil || n == 0 {
+		return false, err
+	}
+	return true, recordEvent(ctx, q, uid, &appID, typ, map[string]any{
+		"application_id": appID,
+		"label_id":       l.ID,
+		"label":          l.Name,
+	})
+}
+
+// applicationLabels: list yanıtı için başvuru id -> etiketler (tek sorgu)
+func applicationLabels(ctx context.Context, q *repo.Queries, uid int64, appIDs []int64) (map[int64][]repo.Label, error) {
+	out := make(map[int64][]repo.Label, len(appIDs))
+	if len(appIDs) == 0 {
+		return out, nil
+	}
+	rows, err := q.ListApplicationLabels(ctx, repo.ListApplicationLabelsParams{ApplicationIds: appIDs, UserID: uid})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		out[row.ApplicationID] = append(out[row.ApplicationID], row.Label)
+	}
+	return out, nil
+}
+
+// jobLabels: list yanıtı için ilan id -> kullanıcının etiketleri (tek sorgu)
+func jobLabels(ctx context.Context, q *repo.Queries, uid int64, jobIDs []int64) (map[int64][]repo.Label, error) {
+	out := make(map[int64][]repo.Label, len(jobIDs))
+	if len(jobIDs) == 0 {
+		return out, nil
+	}
+	rows, err := q.ListJobLabels(ctx, repo.ListJobLabelsParams{JobIds: jobIDs, UserID: uid})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		out[row.JobID] = append(out[row.JobID], row.Label)
+	}
+	return out, nil
+}
+
+// @Summary      Create label
+// @Tags         labels
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      LabelReq  true  "label"
+// @Success      201   {object}  repo.Label
+// @Failure      400   {object}  map[string]string
+// @Failure      409   {object}  map[string]string
+// @Router       /v1/labels [post]
+func (h *LabelsHandler) create(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req LabelReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	f, err := req.validate()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	l, err := h.q.CreateLabel(ctx, repo.CreateLabelParams{UserID: uid, Name: f.Name, Color: f.Color})
+	if err != nil {
+		if isUniqueViolation(err) {
+			writeError(w, http.StatusConflict, "label already exists")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, l)
+}
+
+// @Summary      List labels
+// @Description  Ada göre sıralı; her etiket için bağlı başvuru ve ilan sayısıyla
+// @Tags         labels
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  map[string]any
+// @Router       /v1/labels [get]
+func (h *LabelsHandler) list(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	rows, err := h.q.ListLabels(ctx, uid)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	type item struct {
+		repo.Label
+		ApplicationCount int64 `json:"application_count"`
+		JobCount         int64 `json:"job_count"`
+	}
+	items := make([]item, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, item{Label: row.Label, ApplicationCount: row.ApplicationCount, JobCount: row.JobCount})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": items})
+}
+
+// @Summary      Get label
+// @Description  Bağlı başvuru ve ilan id'leriyle birlikte
+// @Tags         labels
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path      int64  true  "label id"
+// @Success      200  {object}  labelView
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/labels/{id} [get]
+func (h *LabelsHandler) get(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	l, ok := loadLabel(ctx, w, h.q, id, uid)
+	if !ok {
+		return
+	}
+	appIDs, err := h.q.ListLabelApplicationIDs(ctx, id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	jobIDs, err := h.q.ListLabelJobIDs(ctx, id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if appIDs == nil {
+		appIDs = []int64{}
+	}
+	if jobIDs == nil {
+		jobIDs = []int64{}
+	}
+	writeJSON(w, http.StatusOK, labelView{Label: l, ApplicationIDs: appIDs, JobIDs: jobIDs})
+}
+
+// @Summary      Update label (rename / recolor)
+// @Description  Verilmeyen alan değişmez. Bağlantılar id üzerinden olduğu için yeni ad her yerde görünür.
+// @Description  Ad başka bir etiketle çakışıyorsa 409; birleştirmek için /v1/labels/{id}:merge kullanılır.
+// @Tags         labels
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int64     true  "label id"
+// @Param        body  body      LabelReq  true  "patch"
+// @Success      200   {object}  repo.Label
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Failure      409   {object}  map[string]string
+// @Router       /v1/labels/{id} [patch]
+func (h *LabelsHandler) patch(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	cur, err := qtx.GetLabelForUpdate(ctx, repo.GetLabelForUpdateParams{ID: id, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "label not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	req := LabelReq{Name: cur.Name, Color: cur.Color}
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	f, err := req.validate()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	l, err := qtx.UpdateLabel(ctx, repo.UpdateLabelParams{Name: f.Name, Color: f.Color, ID: id, UserID: uid})
+	if err != nil {
+		if isUniqueViolation(err) {
+			writeError(w, http.StatusConflict, "another label has this name (use :merge)")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, l)
+}
+
+// @Summary      Delete label
+// @Description  Etiket tüm başvuru ve ilanlardan kaldırılır
+// @Tags         labels
+// @Security     BearerAuth
+// @Param        id   path  int64  true  "label id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/labels/{id} [delete]
+func (h *LabelsHandler) delete(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	n, err := h.q.DeleteLabel(ctx, repo.DeleteLabelParams{ID: id, UserID: uid})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 {
+		writeError(w, http.StatusNotFound, "label not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type MergeLabelReq struct {
+	Into int64 `json:"into"` // hedef etiket id; kaynak ({id}) silinir
+}
+
+// @Summary      Merge label
+// @Description  {id} etiketinin tüm başvuru ve ilan bağlantılarını into etiketine taşır ve {id}'yi siler.
+// @Description  Her iki etikete de sahip kayıtlar tek bağlantıyla kalır. Etiket kümesi değişen her başvuruya
+// @Description  application.label.removed (kaynak) ve gerekirse application.label.added (hedef) event'i yazılır.
+// @Tags         labels
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int64          true  "kaynak label id"
+// @Param        body  body      MergeLabelReq  true  "hedef"
+// @Success      200   {object}  map[string]any
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Router       /v1/labels/{id}:merge [post]
+func (h *LabelsHandler) merge(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+
+	var req MergeLabelReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	if req.Into <= 0 || req.Into == id {
+		writeError(w, http.StatusBadRequest, "into must be another label id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	// deadlock olmasın diye iki etiket id sırasıyla kilitlenir
+	locked := map[int64]repo.Label{}
+	for _, lid := range []int64{min(id, req.Into), max(id, req.Into)} {
+		l, err := qtx.GetLabelForUpdate(ctx, repo.GetLabelForUpdateParams{ID: lid, UserID: uid})
+		if err != nil {
+			if isNoRows(err) {
+				writeError(w, http.StatusNotFound, "label not found")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+		locked[lid] = l
+	}
+
+	source, target := locked[id], locked[req.Into]
+	// timeline için: kaynağı taşıyan (silinmemiş) her başvuru kaynağı kaybeder,
+	// hedefi henüz taşımayanlar hedefi kazanır
+	sourceApps, err := qtx.ListLabelApplicationIDs(ctx, id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	added, err := qtx.MergeLabelApplications(ctx, repo.MergeLabelApplicationsParams{TargetID: req.Into, SourceID: id})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	gained := make(map[int64]bool, len(added))
+	for _, appID := range added {
+		gained[appID] = true
+	}
+	for _, appID := range sourceApps {
+		if gained[appID] {
+			if err := recordEvent(ctx, qtx, uid, &appID, eventApplicationLabelAdded, map[string]any{
+				"application_id": appID,
+				"label_id":       target.ID,
+				"label":          target.Name,
+				"merge":          true,
+			}); err != nil {
+				writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+				return
+			}
+		}
+		if err := recordEvent(ctx, qtx, uid, &appID, eventApplicationLabelRemoved, map[string]any{
+			"application_id": appID,
+			"label_id":       source.ID,
+			"label":          source.Name,
+			"merge":          true,
+		}); err != nil {
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+	jobs, err := qtx.MergeLabelJobs(ctx, repo.MergeLabelJobsParams{TargetID: req.Into, SourceID: id})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if _, err := qtx.DeleteLabel(ctx, repo.DeleteLabelParams{ID: id, UserID: uid}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"label":              target,
+		"merged_label":       source,
+		"moved_applications": len(added),
+		"moved_jobs":         jobs,
+	})
+}
+
+// @Summary      Add label to application
+// @Tags         labels
+// @Security     BearerAuth
+// @Param        id     path  int64  true  "label id"
+// @Param        appId  path  int64  true  "application id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/labels/{id}/applications/{appId} [put]
+func (h *LabelsHandler) linkApplication(w http.ResponseWriter, r *http.Request) {
+	h.applicationLink(w, r, true)
+}
+
+// @Summary      Remove label from application
+// @Tags         labels
+// @Security     BearerAuth
+// @Param        id     path  int64  true  "label id"
+// @Param        appId  path  int64  true  "application id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/labels/{id}/applications/{appId} [delete]
+func (h *LabelsHandler) unlinkApplication(w http.ResponseWriter, r *http.Request) {
+	h.applicationLink(w, r, false)
+}
+
+// applicationLink: etiket + başvuru sahipliğini kontrol edip bağlar / ayırır.
+// Bağlama idempotent; olmayan bağlantıyı ayırmak 404.
+func (h *LabelsHandler) applicationLink(w http.ResponseWriter, r *http.Request, link bool) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+	appID, ok := pathID(w, r, "appId", "invalid application id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	l, ok := loadLabel(ctx, w, qtx, id, uid)
+	if !ok {
+		return
+	}
+	if _, err := qtx.GetApplicationByID(ctx, repo.GetApplicationByIDParams{ID: appID, UserID: uid}); err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	changed, err := setApplicationLabel(ctx, qtx, uid, appID, l, link)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if !changed && !link {
+		writeError(w, http.StatusNotFound, "link not found")
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Add label to job
+// @Tags         labels
+// @Security     BearerAuth
+// @Param        id     path  int64  true  "label id"
+// @Param        jobId  path  int64  true  "job id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/labels/{id}/jobs/{jobId} [put]
+func (h *LabelsHandler) linkJob(w http.ResponseWriter, r *http.Request) {
+	h.jobLink(w, r, true)
+}
+
+// @Summary      Remove label from job
+// @Tags         labels
+// @Security     BearerAuth
+// @Param        id     path  int64  true  "label id"
+// @Param        jobId  path  int64  true  "job id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/labels/{id}/jobs/{jobId} [delete]
+func (h *LabelsHandler) unlinkJob(w http.ResponseWriter, r *http.Request) {
+	h.jobLink(w, r, false)
+}
+
+func (h *LabelsHandler) jobLink(w http.ResponseWriter, r *http.Request, link bool) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	id, ok := pathID(w, r, "id", "invalid id")
+	if !ok {
+		return
+	}
+	jobID, ok := pathID(w, r, "jobId", "invalid job id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if _, ok := loadLabel(ctx, w, h.q, id, uid); !ok {
+		return
+	}
+	// yalnızca görünür ilanlar etiketlenebilir; ayırma her zaman serbest
+	if link {
+		if _, ok := loadVisibleJob(ctx, w, h.q, jobID, uid); !ok {
+			return
+		}
+	}
+	var (
+		n   int64
+		err error
+	)
+	if link {
+		n, err = h.q.LinkLabelJob(ctx, repo.LinkLabelJobParams{LabelID: id, JobID: jobID})
+	} else {
+		n, err = h.q.UnlinkLabelJob(ctx, repo.UnlinkLabelJobParams{LabelID: id, JobID: jobID})
+	}
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			writeError(w, http.StatusNotFound, "job not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 && !link {
+		writeError(w, http.StatusNotFound, "link not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,742 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+const (
+	// saatlik ücret yıllığa çevrilirken (40 saat x 52 hafta)
+	offerHoursPerYear = 2080
+	// vesting süresi verilmemiş hisse için varsayılan (4 yıl)
+	offerDefaultVestingMonths = 48
+	offerCompareMax           = 10
+)
+
+var (
+	offerPeriods  = map[string]bool{"year": true, "month": true, "hour": true}
+	currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// OffersHandler: başvuru teklifleri ve statik kur tablosuyla karşılaştırma
+type OffersHandler struct {
+	q    *repo.Queries
+	pool *pgxpool.Pool
+	fx   map[string]float64
+}
+
+func NewOffersHandler(pool *pgxpool.Pool, fxRates map[string]float64) *OffersHandler {
+	return &OffersHandler{q: repo.New(pool), pool: pool, fx: fxRates}
+}
+
+// ApplicationRouter: /v1/applications/{id}/offers altı
+func (h *OffersHandler) ApplicationRouter() http.Handler {
+	r := chi.NewRouter()
+	r.Post("/", h.create)            // POST   /v1/applications/{id}/offers
+	r.Get("/", h.list)               // GET    /v1/applications/{id}/offers
+	r.Get("/{offerId}", h.get)       // GET    /v1/applications/{id}/offers/{offerId}
+	r.Patch("/{offerId}", h.patch)   // PATCH  /v1/applications/{id}/offers/{offerId}
+	r.Delete("/{offerId}", h.delete) // DELETE /v1/applications/{id}/offers/{offerId}
+	return r
+}
+
+// Router: /v1/offers altı
+func (h *OffersHandler) Router() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/", h.listAll)        // GET /v1/offers
+	r.Get("/compare", h.compare) // GET /v1/offers/compare
+	return r
+}
+
+// OfferReq: create gövdesi; PATCH'te mevcut kayıt bu yapıya doldurulup gövde
+// üzerine decode edilir (merge patch: null opsiyonel alanı temizler).
+type OfferReq struct {
+	Currency            string   `json:"currency"`      // ISO 4217, örn. EUR
+	BaseSalary          int64    `json:"base_salary"`   // salary_period başına
+	SalaryPeriod        string   `json:"salary_period"` // year | month | hour (default year)
+	Bonus               *int64   `json:"bonus"`         // yıllık hedef bonus
+	SigningBonus        *int64   `json:"signing_bonus"`
+	EquityValue         *int64   `json:"equity_value"` // toplam hisse/opsiyon değeri
+	EquityVestingMonths *int32   `json:"equity_vesting_months"`
+	EquityCliffMonths   *int32   `json:"equity_cliff_months"`
+	Benefits            []string `json:"benefits"`
+	StartDate           *string  `json:"start_date"` // YYYY-MM-DD
+	RespondBy           *string  `json:"respond_by"` // RFC3339, yanıt için son tarih
+	Notes               *string  `json:"notes"`
+}
+
+// validate: doğrulanmış ve normalize edilmiş alanlar (ID / ApplicationID çağıran doldurur)
+func (req OfferReq) validate() (repo.UpdateOfferParams, error) {
+	f := repo.UpdateOfferParams{
+		Currency:            strings.ToUpper(strings.TrimSpace(req.Currency)),
+		BaseSalary:          req.BaseSalary,
+		SalaryPeriod:        req.SalaryPeriod,
+		Bonus:               req.Bonus,
+		SigningBonus:        req.SigningBonus,
+		EquityValue:         req.EquityValue,
+		EquityVestingMonths: req.EquityVestingMonths,
+		EquityCliffMonths:   req.EquityCliffMonths,
+		Notes:               trimmedOrNil(req.Notes),
+	}
+	if !currencyRegex.MatchString(f.Currency) {
+		return f, errors.New("invalid currency (ISO 4217, e.g. EUR)")
+	}
+	if f.BaseSalary < 0 {
+		return f, errors.New("base_salary must be >= 0")
+	}
+	if f.SalaryPeriod == "" {
+		f.SalaryPeriod = "year"
+	}
+	if !offerPeriods[f.SalaryPeriod] {
+		return f, errors.New("invalid salary_period, must be one of: year, month, hour")
+	}
+	for name, v := range map[string]*int64{"bonus": f.Bonus, "signing_bonus": f.SigningBonus, "equity_value": f.EquityValue} {
+		if v != nil && *v < 0 {
+			return f, errors.New(name + " must be >= 0")
+		}
+	}
+	if f.EquityVestingMonths != nil && *f.EquityVestingMonths <= 0 {
+		return f, errors.New("equity_vesting_months must be > 0")
+	}
+	if f.EquityCliffMonths != nil && *f.EquityCliffMonths < 0 {
+		return f, errors.New("equity_cliff_months must be >= 0")
+	}
+	f.Benefits = []string{}
+	for _, b := range req.Benefits {
+		if b = strings.TrimSpace(b); b != "" {
+			f.Benefits = append(f.Benefits, b)
+		}
+	}
+	if req.StartDate != nil && *req.StartDate != "" {
+		t, err := time.Parse("2006-01-02", *req.StartDate)
+		if err != nil {
+			return f, errors.New("invalid start_date (YYYY-MM-DD)")
+		}
+		f.StartDate = &t
+	}
+	if req.RespondBy != nil && *req.RespondBy != "" {
+		t, err := time.Parse(time.RFC3339, *req.RespondBy)
+		if err != nil {
+			return f, errors.New("invalid respond_by (RFC3339)")
+		}
+		f.RespondBy = &t
+	}
+	return f, nil
+}
+
+// offerReqFrom: mevcut kaydı PATCH için istek yapısına çevirir
+func offerReqFrom(o repo.Offer) OfferReq {
+	req := OfferReq{
+		Currency:            o.Currency,
+		BaseSalary:          o.BaseSalary,
+		SalaryPeriod:        o.SalaryPeriod,
+		Bonus:               o.Bonus,
+		SigningBonus:        o.SigningBonus,
+		EquityValue:         o.EquityValue,
+		EquityVestingMonths: o.EquityVestingMonths,
+		EquityCliffMonths:   o.EquityCliffMonths,
+		Benefits:            o.Benefits,
+		Notes:               o.Notes,
+	}
+	if o.StartDate != nil {
+		s := o.StartDate.Format("2006-01-02")
+		req.StartDate = &s
+	}
+	if o.RespondBy != nil {
+		s := o.RespondBy.Format(time.RFC3339)
+		req.RespondBy = &s
+	}
+	return req
+}
+
+// offerView: start_date gün olarak döner
+type offerView struct {
+	repo.Offer
+	StartDate *string `json:"start_date"`
+}
+
+func viewOffer(o repo.Offer) offerView {
+	v := offerView{Offer: o}
+	if o.StartDate != nil {
+		s := o.StartDate.Format("2006-01-02")
+		v.StartDate = &s
+	}
+	if v.Benefits == nil {
+		v.Benefits = []string{}
+	}
+	return v
+}
+
+// offerPathIDs: {id} ve {offerId} path parametreleri
+func offerPathIDs(w http.ResponseWriter, r *http.Request, withOffer bool) (appID, offerID int64, ok bool) {
+	appID, ok = pathID(w, r, "id", "invalid id")
+	if !ok || !withOffer {
+		return appID, 0, ok
+	}
+	offerID, ok = pathID(w, r, "offerId", "invalid offer id")
+	return appID, offerID, ok
+}
+
+// loadApplication: başvurunun kullanıcıya ait olduğunu doğrular (değilse 404)
+func (h *OffersHandler) loadApplication(ctx context.Context, w http.ResponseWriter, appID, uid int64) bool {
+	if _, err := h.q.GetApplicationByID(ctx, repo.GetApplicationByIDParams{ID: appID, UserID: uid}); err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return false
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// @Summary      Add offer
+// @Description  Başvuruya teklif ekler. İlk teklifte, pipeline izin veriyorsa status otomatik offer olur.
+// @Tags         offers
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int64     true  "application id"
+// @Param        body  body      OfferReq  true  "offer payload"
+// @Success      201   {object}  offerView
+// @Failure      400   {object}  map[string]string
+// @Failure      404   {object}  map[string]string
+// @Router       /v1/applications/{id}/offers [post]
+func (h *OffersHandler) create(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, _, ok := offerPathIDs(w, r, false)
+	if !ok {
+		return
+	}
+
+	var req OfferReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	f, err := req.validate()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	app, err := qtx.GetApplicationForUpdate(ctx, repo.GetApplicationForUpdateParams{ID: appID, UserID: uid})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "application not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	prior, err := qtx.ListOffersByApplication(ctx, appID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	o, err := qtx.CreateOffer(ctx, repo.CreateOfferParams{
+		ApplicationID:       appID,
+		Currency:            f.Currency,
+		BaseSalary:          f.BaseSalary,
+		SalaryPeriod:        f.SalaryPeriod,
+		Bonus:               f.Bonus,
+		SigningBonus:        f.SigningBonus,
+		EquityValue:         f.EquityValue,
+		EquityVestingMonths: f.EquityVestingMonths,
+		EquityCliffMonths:   f.EquityCliffMonths,
+		Benefits:            f.Benefits,
+		StartDate:           f.StartDate,
+		RespondBy:           f.RespondBy,
+		Notes:               f.Notes,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventOfferReceived, map[string]any{
+		"application_id": appID,
+		"offer_id":       o.ID,
+		"currency":       o.Currency,
+		"base_salary":    o.BaseSalary,
+		"salary_period":  o.SalaryPeriod,
+		"respond_by":     o.RespondBy,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	// ilk teklif: pipeline izin veriyorsa status -> offer
+	if len(prior) == 0 {
+		if err := autoAdvanceStatus(ctx, qtx, uid, app, "offer", "offer received"); err != nil {
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, viewOffer(o))
+}
+
+// @Summary      List offers of application
+// @Tags         offers
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path  int64  true  "application id"
+// @Success      200  {object}  map[string]any
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/offers [get]
+func (h *OffersHandler) list(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, _, ok := offerPathIDs(w, r, false)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadApplication(ctx, w, appID, uid) {
+		return
+	}
+	offers, err := h.q.ListOffersByApplication(ctx, appID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	items := make([]offerView, 0, len(offers))
+	for _, o := range offers {
+		items = append(items, viewOffer(o))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": items})
+}
+
+// @Summary      Get offer
+// @Tags         offers
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id       path  int64  true  "application id"
+// @Param        offerId  path  int64  true  "offer id"
+// @Success      200  {object}  offerView
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/offers/{offerId} [get]
+func (h *OffersHandler) get(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, offerID, ok := offerPathIDs(w, r, true)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadApplication(ctx, w, appID, uid) {
+		return
+	}
+	o, err := h.q.GetOffer(ctx, repo.GetOfferParams{ID: offerID, ApplicationID: appID})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "offer not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, viewOffer(o))
+}
+
+// @Summary      Update offer (merge patch)
+// @Description  JSON Merge Patch: verilmeyen alan değişmez, null opsiyonel alanı temizler.
+// @Tags         offers
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int64     true  "application id"
+// @Param        offerId  path      int64     true  "offer id"
+// @Param        body     body      OfferReq  true  "patch"
+// @Success      200      {object}  offerView
+// @Failure      400      {object}  map[string]string
+// @Failure      404      {object}  map[string]string
+// @Router       /v1/applications/{id}/offers/{offerId} [patch]
+func (h *OffersHandler) patch(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, offerID, ok := offerPathIDs(w, r, true)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadApplication(ctx, w, appID, uid) {
+		return
+	}
+	cur, err := h.q.GetOffer(ctx, repo.GetOfferParams{ID: offerID, ApplicationID: appID})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "offer not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	req := offerReqFrom(cur)
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	f, err := req.validate()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	f.ID = offerID
+	f.ApplicationID = appID
+	o, err := qtx.UpdateOffer(ctx, f)
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "offer not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventOfferUpdated, map[string]any{
+		"application_id": appID,
+		"offer_id":       o.ID,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, viewOffer(o))
+}
+
+// @Summary      Delete offer
+// @Tags         offers
+// @Security     BearerAuth
+// @Param        id       path  int64  true  "application id"
+// @Param        offerId  path  int64  true  "offer id"
+// @Success      204  "No Content"
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/applications/{id}/offers/{offerId} [delete]
+func (h *OffersHandler) delete(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	appID, offerID, ok := offerPathIDs(w, r, true)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadApplication(ctx, w, appID, uid) {
+		return
+	}
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	n, err := qtx.DeleteOffer(ctx, repo.DeleteOfferParams{ID: offerID, ApplicationID: appID})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if n == 0 {
+		writeError(w, http.StatusNotFound, "offer not found")
+		return
+	}
+	if err := recordEvent(ctx, qtx, uid, &appID, eventOfferDeleted, map[string]any{
+		"application_id": appID,
+		"offer_id":       offerID,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type offerListItem struct {
+	Offer             offerView `json:"offer"`
+	ApplicationStatus string    `json:"application_status"`
+	JobTitle          string    `json:"job_title"`
+	JobCompany        string    `json:"job_company"`
+}
+
+// @Summary      List all offers
+// @Description  Kullanıcının tüm başvurularındaki teklifler (ilan bilgisiyle), yeniden eskiye
+// @Tags         offers
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  map[string]any
+// @Router       /v1/offers [get]
+func (h *OffersHandler) listAll(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	rows, err := h.q.ListOffersByUser(ctx, repo.ListOffersByUserParams{UserID: uid})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	items := make([]offerListItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, offerListItem{
+			Offer:             viewOffer(row.Offer),
+			ApplicationStatus: row.ApplicationStatus,
+			JobTitle:          row.JobTitle,
+			JobCompany:        row.JobCompany,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": items})
+}
+
+// normalizedComp: hedef para biriminde yıllıklandırılmış ücret kalemleri
+type normalizedComp struct {
+	Currency       string  `json:"currency"`
+	FXRate         float64 `json:"fx_rate"` // teklif para birimi -> hedef
+	BaseAnnual     int64   `json:"base_annual"`
+	Bonus          int64   `json:"bonus"`
+	EquityAnnual   int64   `json:"equity_annual"` // equity_value / vesting yılı (vesting < 1 yıl: tamamı)
+	SigningBonus   int64   `json:"signing_bonus"`
+	AnnualTotal    int64   `json:"annual_total"`     // base + bonus + yıllık hisse
+	FirstYearTotal int64   `json:"first_year_total"` // + signing bonus; cliff 12 aydan uzunsa hisse hariç
+}
+
+// normalizeOffer: rate = 1 birim teklif para biriminin hedef para birimindeki karşılığı
+func normalizeOffer(o repo.Offer, currency string, rate float64) normalizedComp {
+	conv := func(v float64) int64 { return int64(math.Round(v * rate)) }
+	base := float64(o.BaseSalary)
+	switch o.SalaryPeriod {
+	case "month":
+		base *= 12
+	case "hour":
+		base *= offerHoursPerYear
+	}
+	var bonus, signing, equity float64
+	if o.Bonus != nil {
+		bonus = float64(*o.Bonus)
+	}
+	if o.SigningBonus != nil {
+		signing = float64(*o.SigningBonus)
+	}
+	if o.EquityValue != nil {
+		months := float64(offerDefaultVestingMonths)
+		if o.EquityVestingMonths != nil {
+			months = float64(*o.EquityVestingMonths)
+		}
+		// vesting bir yıldan kısaysa bir yılda hisse değerinin tamamından fazlası açılmaz
+		equity = float64(*o.EquityValue) * math.Min(12, months) / months
+	}
+	firstYearEquity := equity
+	if o.EquityCliffMonths != nil && *o.EquityCliffMonths > 12 {
+		firstYearEquity = 0
+	}
+	return normalizedComp{
+		Currency:       currency,
+		FXRate:         math.Round(rate*1e6) / 1e6,
+		BaseAnnual:     conv(base),
+		Bonus:          conv(bonus),
+		EquityAnnual:   conv(equity),
+		SigningBonus:   conv(signing),
+		AnnualTotal:    conv(base + bonus + equity),
+		FirstYearTotal: conv(base + bonus + firstYearEquity + signing),
+	}
+}
+
+type offerComparison struct {
+	offerListItem
+	Normalized normalizedComp `json:"normalized"`
+	Rank       int            `json:"rank"` // annual_total'e göre, 1 = en yüksek
+}
+
+// @Summary      Compare offers
+// @Description  Teklifleri seçilen para birimine (statik FX_RATES tablosu) çevirip yıllıklandırır:
+// @Description  aylık x12, saatlik x2080, hisse değeri / vesting yılı (default 4 yıl; 1 yıldan kısa vesting'de değerin tamamı).
+// @Description  Sonuç ids sırasıyla döner; rank annual_total'e göre.
+// @Tags         offers
+// @Security     BearerAuth
+// @Produce      json
+// @Param        ids       query  string  true   "virgülle ayrılmış offer id'leri (en fazla 10)"
+// @Param        currency  query  string  false  "hedef para birimi (default ilk teklifin para birimi)"
+// @Success      200  {object}  map[string]any
+// @Failure      400  {object}  map[string]string
+// @Failure      404  {object}  map[string]any
+// @Router       /v1/offers/compare [get]
+func (h *OffersHandler) compare(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var ids []int64
+	seen := map[int64]bool{}
+	for _, s := range strings.Split(r.URL.Query().Get("ids"), ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || id <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid ids")
+			return
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 || len(ids) > offerCompareMax {
+		writeError(w, http.StatusBadRequest, "ids required (1-"+strconv.Itoa(offerCompareMax)+" offer ids)")
+		return
+	}
+	currency := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if currency != "" && h.fx[currency] == 0 {
+		writeError(w, http.StatusBadRequest, "no FX rate configured for "+currency)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	rows, err := h.q.ListOffersByUser(ctx, repo.ListOffersByUserParams{UserID: uid, Ids: ids})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	byID := make(map[int64]repo.ListOffersByUserRow, len(rows))
+	for _, row := range rows {
+		byID[row.Offer.ID] = row
+	}
+	missing := []int64{}
+	for _, id := range ids {
+		if _, ok := byID[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		writeJSON(w, http.StatusNotFound, map[string]any{"error": "offer not found", "ids": missing})
+		return
+	}
+	if currency == "" {
+		currency = byID[ids[0]].Offer.Currency
+	}
+	target := h.fx[currency]
+	if target == 0 {
+		writeError(w, http.StatusBadRequest, "no FX rate configured for "+currency)
+		return
+	}
+
+	items := make([]offerComparison, 0, len(ids))
+	for _, id := range ids {
+		row := byID[id]
+		src := h.fx[row.Offer.Currency]
+		if src == 0 {
+			writeError(w, http.StatusBadRequest, "no FX rate configured for "+row.Offer.Currency)
+			return
+		}
+		items = append(items, offerComparison{
+			offerListItem: offerListItem{
+				Offer:             viewOffer(row.Offer),
+				ApplicationStatus: row.ApplicationStatus,
+				JobTitle:          row.JobTitle,
+				JobCompany:        row.JobCompany,
+			},
+			Normalized: normalizeOffer(row.Offer, currency, src/target),
+		})
+	}
+
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return items[order[a]].Normalized.AnnualTotal > items[order[b]].Normalized.AnnualTotal
+	})
+	for pos, i := range order {
+		items[i].Rank = pos + 1
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"currency": currency,
+		"items":    items,
+	})
+}
@@ -0,0 +1,94 @@
+package httpx
+
+import (
+	"testing"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+func TestNormalizeOffer(t *testing.T) {
+	i64 := func(v int64) *int64 { return &v }
+	i32 := func(v int32) *int32 { return &v }
+
+	tests := []struct {
+		name  string
+		offer repo.Offer
+		rate  float64
+		want  normalizedComp
+	}{
+		{
+			name:  "yearly base",
+			offer: repo.Offer{BaseSalary: 100000, SalaryPeriod: "year"},
+			rate:  1,
+			want:  normalizedComp{FXRate: 1, BaseAnnual: 100000, AnnualTotal: 100000, FirstYearTotal: 100000},
+		},
+		{
+			name:  "monthly base",
+			offer: repo.Offer{BaseSalary: 5000, SalaryPeriod: "month"},
+			rate:  1,
+			want:  normalizedComp{FXRate: 1, BaseAnnual: 60000, AnnualTotal: 60000, FirstYearTotal: 60000},
+		},
+		{
+			name:  "hourly base",
+			offer: repo.Offer{BaseSalary: 50, SalaryPeriod: "hour"},
+			rate:  1,
+			want:  normalizedComp{FXRate: 1, BaseAnnual: 104000, AnnualTotal: 104000, FirstYearTotal: 104000},
+		},
+		{
+			name:  "bonus and signing bonus",
+			offer: repo.Offer{BaseSalary: 100000, SalaryPeriod: "year", Bonus: i64(10000), SigningBonus: i64(5000)},
+			rate:  1,
+			want: normalizedComp{FXRate: 1, BaseAnnual: 100000, Bonus: 10000, SigningBonus: 5000,
+				AnnualTotal: 110000, FirstYearTotal: 115000},
+		},
+		{
+			name:  "equity default vesting",
+			offer: repo.Offer{BaseSalary: 100000, SalaryPeriod: "year", EquityValue: i64(400000)},
+			rate:  1,
+			want: normalizedComp{FXRate: 1, BaseAnnual: 100000, EquityAnnual: 100000,
+				AnnualTotal: 200000, FirstYearTotal: 200000},
+		},
+		{
+			name:  "equity vesting shorter than a year",
+			offer: repo.Offer{BaseSalary: 100000, SalaryPeriod: "year", EquityValue: i64(60000), EquityVestingMonths: i32(6)},
+			rate:  1,
+			want: normalizedComp{FXRate: 1, BaseAnnual: 100000, EquityAnnual: 60000,
+				AnnualTotal: 160000, FirstYearTotal: 160000},
+		},
+		{
+			name: "cliff longer than a year",
+			offer: repo.Offer{BaseSalary: 100000, SalaryPeriod: "year", SigningBonus: i64(5000),
+				EquityValue: i64(400000), EquityVestingMonths: i32(48), EquityCliffMonths: i32(18)},
+			rate: 1,
+			want: normalizedComp{FXRate: 1, BaseAnnual: 100000, EquityAnnual: 100000, SigningBonus: 5000,
+				AnnualTotal: 200000, FirstYearTotal: 105000},
+		},
+		{
+			name:  "cliff of exactly a year",
+			offer: repo.Offer{BaseSalary: 100000, SalaryPeriod: "year", EquityValue: i64(400000), EquityCliffMonths: i32(12)},
+			rate:  1,
+			want: normalizedComp{FXRate: 1, BaseAnnual: 100000, EquityAnnual: 100000,
+				AnnualTotal: 200000, FirstYearTotal: 200000},
+		},
+		{
+			name:  "currency conversion rounds",
+			offer: repo.Offer{BaseSalary: 100001, SalaryPeriod: "year"},
+			rate:  0.5,
+			want:  normalizedComp{FXRate: 0.5, BaseAnnual: 50001, AnnualTotal: 50001, FirstYearTotal: 50001},
+		},
+		{
+			name:  "fx rate rounded to six decimals",
+			offer: repo.Offer{BaseSalary: 3000, SalaryPeriod: "year"},
+			rate:  1.0 / 3,
+			want:  normalizedComp{FXRate: 0.333333, BaseAnnual: 1000, AnnualTotal: 1000, FirstYearTotal: 1000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Currency = "EUR"
+			if got := normalizeOffer(tt.offer, "EUR", tt.rate); got != tt.want {
+				t.Fatalf("normalizeOffer() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
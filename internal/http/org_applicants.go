@@ -0,0 +1,619 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+const orgPipelineMaxStages = 20
+
+var orgStageRe = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// orgPathIDs: /orgs/{id}/jobs/{jobId}/... path parametreleri
+func orgPathIDs(w http.ResponseWriter, r *http.Request) (orgID, jobID int64, ok bool) {
+	if orgID, ok = pathID(w, r, "id", "invalid org id"); !ok {
+		return 0, 0, false
+	}
+	if jobID, ok = pathID(w, r, "jobId", "invalid job id"); !ok {
+		return 0, 0, false
+	}
+	return orgID, jobID, true
+}
+
+// requireOrgRole: çağıranın org'da verilen rollerden birine sahip olduğunu doğrular (değilse 403)
+func requireOrgRole(ctx context.Context, w http.ResponseWriter, q *repo.Queries, orgID, uid int64, roles ...string) bool {
+	role, err := q.GetOrgMemberRole(ctx, repo.GetOrgMemberRoleParams{OrgID: orgID, UserID: uid})
+	if err != nil && !isNoRows(err) {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return false
+	}
+	if err != nil || !slices.Contains(roles, role) {
+		writeError(w, http.StatusForbidden, "forbidden")
+		return false
+	}
+	return true
+}
+
+// loadOrgApplicantsJob: owner/admin kontrolü + ilanın org'a ait olduğu (değilse 404)
+func (h *OrgsHandler) loadOrgApplicantsJob(ctx context.Context, w http.ResponseWriter, orgID, jobID, uid int64) bool {
+	if !requireOrgRole(ctx, w, h.q, orgID, uid, "owner", "admin") {
+		return false
+	}
+	if _, err := h.q.GetOrgJob(ctx, repo.GetOrgJobParams{ID: jobID, OrgID: orgID}); err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "job not found")
+			return false
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return false
+	}
+	return true
+}
+
+type applicantCandidate struct {
+	ID    int64  `json:"id"`
+	Email string `json:"email"`
+}
+
+// applicantView: org'un gördüğü başvuru; adayın kendi status'u ve notları yer almaz
+type applicantView struct {
+	ApplicationID  int64              `json:"application_id"`
+	AppliedAt      time.Time          `json:"applied_at"`
+	Candidate      applicantCandidate `json:"candidate"`
+	Stage          string             `json:"stage"` // org pipeline aşaması
+	StageChangedAt *time.Time         `json:"stage_changed_at"`
+	NoteCount      int64              `json:"note_count"`
+}
+
+func applicantViewFrom(row repo.ListJobApplicantsRow) applicantView {
+	return applicantView{
+		ApplicationID:  row.ApplicationID,
+		AppliedAt:      row.AppliedAt,
+		Candidate:      applicantCandidate{ID: row.CandidateID, Email: row.CandidateEmail},
+		Stage:          row.Stage,
+		StageChangedAt: row.StageChangedAt,
+		NoteCount:      row.NoteCount,
+	}
+}
+
+// @Summary      Get org pipeline
+// @Description  Org'un aday pipeline aşamaları (sıralı). Adayın kendi başvuru status'undan bağımsızdır.
+// @Tags         orgs
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id   path      int64  true  "org id"
+// @Success      200  {object}  map[string]any
+// @Failure      403  {object}  map[string]string
+// @Router       /v1/orgs/{id}/pipeline [get]
+func (h *OrgsHandler) getPipeline(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	orgID, ok := pathID(w, r, "id", "invalid org id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !requireOrgRole(ctx, w, h.q, orgID, uid, "owner", "admin", "member") {
+		return
+	}
+	stages, err := h.q.ListOrgPipelineStages(ctx, orgID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if stages == nil {
+		stages = []string{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"stages": stages})
+}
+
+type PipelineReq struct {
+	Stages []string `json:"stages"` // sıralı; [a-z0-9_], en fazla 20
+}
+
+// @Summary      Replace org pipeline
+// @Description  Aşama listesini verilen sırayla değiştirir (owner/admin). Adayı olan bir aşama
+// @Description  listeden çıkarılamaz (409). Aşamasız adaylar ilk aşamada sayılır; ilk aşama değişirse
+// @Description  önce eski ilk aşamaya sabitlenirler (yeni ilk aşamaya kaymazlar).
+// @Tags         orgs
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int64        true  "org id"
+// @Param        body  body      PipelineReq  true  "stages"
+// @Success      200   {object}  map[string]any
+// @Failure      400   {object}  map[string]string
+// @Failure      403   {object}  map[string]string
+// @Failure      409   {object}  map[string]string
+// @Router       /v1/orgs/{id}/pipeline [put]
+func (h *OrgsHandler) putPipeline(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	orgID, ok := pathID(w, r, "id", "invalid org id")
+	if !ok {
+		return
+	}
+
+	var req PipelineReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	if len(req.Stages) == 0 || len(req.Stages) > orgPipelineMaxStages {
+		writeError(w, http.StatusBadRequest, "stages required (1-"+strconv.Itoa(orgPipelineMaxStages)+")")
+		return
+	}
+	seen := map[string]bool{}
+	for _, s := range req.Stages {
+		if !orgStageRe.MatchString(s) {
+			writeError(w, http.StatusBadRequest, "invalid stage: "+s+" (a-z, 0-9, _; max 32)")
+			return
+		}
+		if seen[s] {
+			writeError(w, http.StatusBadRequest, "duplicate stage: "+s)
+			return
+		}
+		seen[s] = true
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !requireOrgRole(ctx, w, h.q, orgID, uid, "owner", "admin") {
+		return
+	}
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	// ilk aşama değişiyorsa aşamasız adaylar eski ilk aşamaya sabitlenir; o aşama
+	// listeden çıkarılıyorsa aşağıdaki FK kontrolü 409 verir
+	current, err := qtx.ListOrgPipelineStages(ctx, orgID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if len(current) > 0 && current[0] != req.Stages[0] {
+		if _, err := qtx.PinImplicitApplicantStages(ctx, repo.PinImplicitApplicantStagesParams{
+			Stage: current[0],
+			OrgID: orgID,
+		}); err != nil {
+			writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+			return
+		}
+	}
+	if _, err := qtx.DeleteOrgPipelineStagesExcept(ctx, repo.DeleteOrgPipelineStagesExceptParams{
+		OrgID:  orgID,
+		Stages: req.Stages,
+	}); err != nil {
+		if isForeignKeyViolation(err) {
+			writeError(w, http.StatusConflict, "a removed stage still has applicants")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := qtx.UpsertOrgPipelineStages(ctx, repo.UpsertOrgPipelineStagesParams{
+		OrgID:  orgID,
+		Stages: req.Stages,
+	}); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"stages": req.Stages})
+}
+
+// @Summary      List job applicants
+// @Description  Org ilanına başvuran adaylar (owner/admin). Adayın kendi status'u ve notları görünmez;
+// @Description  henüz başvurmamış (saved) ve silinen başvurular listelenmez. stage: org pipeline aşaması.
+// @Tags         orgs
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id      path   int64   true   "org id"
+// @Param        jobId   path   int64   true   "job id"
+// @Param        stage   query  string  false  "org pipeline aşaması"
+// @Param        limit   query  int     false  "limit (1-100)"
+// @Param        offset  query  int     false  "offset"
+// @Success      200  {object}  map[string]any
+// @Failure      403  {object}  map[string]string
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/orgs/{id}/jobs/{jobId}/applicants [get]
+func (h *OrgsHandler) listApplicants(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	orgID, jobID, ok := orgPathIDs(w, r)
+	if !ok {
+		return
+	}
+
+	q := r.URL.Query()
+	params := repo.ListJobApplicantsParams{JobID: jobID, OrgID: orgID, Limit: 20}
+	if s := q.Get("stage"); s != "" {
+		params.Stage = &s
+	}
+	if s := q.Get("limit"); s != "" {
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v > 0 && v <= 100 {
+			params.Limit = int32(v)
+		}
+	}
+	if s := q.Get("offset"); s != "" {
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v >= 0 {
+			params.Offset = int32(v)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadOrgApplicantsJob(ctx, w, orgID, jobID, uid) {
+		return
+	}
+	rows, err := h.q.ListJobApplicants(ctx, params)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	items := make([]applicantView, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, applicantViewFrom(row))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"items":  items,
+		"limit":  params.Limit,
+		"offset": params.Offset,
+	})
+}
+
+// loadApplicant: başvurunun bu org ilanına ait ve görünür olduğunu doğrular (değilse 404)
+func (h *OrgsHandler) loadApplicant(ctx context.Context, w http.ResponseWriter, q *repo.Queries, orgID, jobID, appID int64) (applicantView, bool) {
+	row, err := q.GetJobApplicant(ctx, repo.GetJobApplicantParams{ApplicationID: appID, JobID: jobID, OrgID: orgID})
+	if err != nil {
+		if isNoRows(err) {
+			writeError(w, http.StatusNotFound, "applicant not found")
+			return applicantView{}, false
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return applicantView{}, false
+	}
+	return applicantViewFrom(repo.ListJobApplicantsRow(row)), true
+}
+
+// @Summary      Get job applicant
+// @Tags         orgs
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id     path  int64  true  "org id"
+// @Param        jobId  path  int64  true  "job id"
+// @Param        appId  path  int64  true  "application id"
+// @Success      200  {object}  applicantView
+// @Failure      403  {object}  map[string]string
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/orgs/{id}/jobs/{jobId}/applicants/{appId} [get]
+func (h *OrgsHandler) getApplicant(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	orgID, jobID, ok := orgPathIDs(w, r)
+	if !ok {
+		return
+	}
+	appID, ok := pathID(w, r, "appId", "invalid application id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadOrgApplicantsJob(ctx, w, orgID, jobID, uid) {
+		return
+	}
+	a, ok := h.loadApplicant(ctx, w, h.q, orgID, jobID, appID)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, a)
+}
+
+type MoveApplicantReq struct {
+	Stage string `json:"stage"` // org pipeline aşaması (bkz. /v1/orgs/{id}/pipeline)
+}
+
+// @Summary      Move applicant in org pipeline
+// @Description  Adayı org pipeline'ında istenen aşamaya taşır; adayın kendi başvuru status'u değişmez.
+// @Tags         orgs
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id     path  int64             true  "org id"
+// @Param        jobId  path  int64             true  "job id"
+// @Param        appId  path  int64             true  "application id"
+// @Param        body   body  MoveApplicantReq  true  "hedef aşama"
+// @Success      200  {object}  applicantView
+// @Failure      400  {object}  map[string]string
+// @Failure      403  {object}  map[string]string
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/orgs/{id}/jobs/{jobId}/applicants/{appId}:move [post]
+func (h *OrgsHandler) moveApplicant(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	orgID, jobID, ok := orgPathIDs(w, r)
+	if !ok {
+		return
+	}
+	appID, ok := pathID(w, r, "appId", "invalid application id")
+	if !ok {
+		return
+	}
+
+	var req MoveApplicantReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadOrgApplicantsJob(ctx, w, orgID, jobID, uid) {
+		return
+	}
+	stages, err := h.q.ListOrgPipelineStages(ctx, orgID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if !slices.Contains(stages, req.Stage) {
+		writeError(w, http.StatusBadRequest, "invalid stage, must be one of: "+strings.Join(stages, ", "))
+		return
+	}
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	cur, ok := h.loadApplicant(ctx, w, qtx, orgID, jobID, appID)
+	if !ok {
+		return
+	}
+	if cur.Stage == req.Stage {
+		writeJSON(w, http.StatusOK, cur)
+		return
+	}
+	review, err := qtx.UpsertApplicantReview(ctx, repo.UpsertApplicantReviewParams{
+		ApplicationID: appID,
+		OrgID:         orgID,
+		Stage:         req.Stage,
+		ChangedBy:     &uid,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			// aşama bu arada pipeline'dan çıkarıldı
+			writeError(w, http.StatusConflict, "stage no longer in pipeline")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	cur.Stage = review.Stage
+	cur.StageChangedAt = &review.StageChangedAt
+	writeJSON(w, http.StatusOK, cur)
+}
+
+type ApplicantNoteReq struct {
+	Body string `json:"body"` // markdown; sadece org üyeleri görür
+}
+
+type applicantNoteView struct {
+	repo.ApplicantNote
+	AuthorEmail *string `json:"author_email"`
+}
+
+// @Summary      Add internal applicant note
+// @Description  Sadece org owner/admin'lerinin gördüğü not; aday hiçbir endpoint'ten göremez.
+// @Tags         orgs
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id     path  int64             true  "org id"
+// @Param        jobId  path  int64             true  "job id"
+// @Param        appId  path  int64             true  "application id"
+// @Param        body   body  ApplicantNoteReq  true  "note"
+// @Success      201  {object}  repo.ApplicantNote
+// @Failure      400  {object}  map[string]string
+// @Failure      403  {object}  map[string]string
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/orgs/{id}/jobs/{jobId}/applicants/{appId}/notes [post]
+func (h *OrgsHandler) createApplicantNote(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	orgID, jobID, ok := orgPathIDs(w, r)
+	if !ok {
+		return
+	}
+	appID, ok := pathID(w, r, "appId", "invalid application id")
+	if !ok {
+		return
+	}
+
+	var req ApplicantNoteReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	body, ok := validateNoteBody(req.Body)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "body required (max 20000 chars)")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadOrgApplicantsJob(ctx, w, orgID, jobID, uid) {
+		return
+	}
+	if _, ok := h.loadApplicant(ctx, w, h.q, orgID, jobID, appID); !ok {
+		return
+	}
+	note, err := h.q.CreateApplicantNote(ctx, repo.CreateApplicantNoteParams{
+		ApplicationID: appID,
+		OrgID:         orgID,
+		AuthorID:      &uid,
+		Body:          body,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, note)
+}
+
+// @Summary      List internal applicant notes
+// @Tags         orgs
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id     path  int64  true  "org id"
+// @Param        jobId  path  int64  true  "job id"
+// @Param        appId  path  int64  true  "application id"
+// @Success      200  {object}  map[string]any
+// @Failure      403  {object}  map[string]string
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/orgs/{id}/jobs/{jobId}/applicants/{appId}/notes [get]
+func (h *OrgsHandler) listApplicantNotes(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	orgID, jobID, ok := orgPathIDs(w, r)
+	if !ok {
+		return
+	}
+	appID, ok := pathID(w, r, "appId", "invalid application id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadOrgApplicantsJob(ctx, w, orgID, jobID, uid) {
+		return
+	}
+	if _, ok := h.loadApplicant(ctx, w, h.q, orgID, jobID, appID); !ok {
+		return
+	}
+	rows, err := h.q.ListApplicantNotes(ctx, repo.ListApplicantNotesParams{ApplicationID: appID, OrgID: orgID})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	items := make([]applicantNoteView, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, applicantNoteView{ApplicantNote: row.ApplicantNote, AuthorEmail: row.AuthorEmail})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"items": items})
+}
+
+// @Summary      Get applicant profile
+// @Description  Adayın profili; sadece aday bu org'un ilanına başvurduysa ve share_with_orgs açıksa görünür
+// @Description  (aksi halde 404). Adayın diğer başvuruları ve notları yer almaz.
+// @Tags         orgs
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id     path  int64  true  "org id"
+// @Param        jobId  path  int64  true  "job id"
+// @Param        appId  path  int64  true  "application id"
+// @Success      200  {object}  profileView
+// @Failure      403  {object}  map[string]string
+// @Failure      404  {object}  map[string]string
+// @Router       /v1/orgs/{id}/jobs/{jobId}/applicants/{appId}/profile [get]
+func (h *OrgsHandler) getApplicantProfile(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	orgID, jobID, ok := orgPathIDs(w, r)
+	if !ok {
+		return
+	}
+	appID, ok := pathID(w, r, "appId", "invalid application id")
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	if !h.loadOrgApplicantsJob(ctx, w, orgID, jobID, uid) {
+		return
+	}
+	a, ok := h.loadApplicant(ctx, w, h.q, orgID, jobID, appID)
+	if !ok {
+		return
+	}
+	p, found, err := loadProfile(ctx, h.q, a.Candidate.ID, a.Candidate.Email)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	// paylaşım kapalıysa profilin varlığı da belli edilmez
+	if !found || !p.ShareWithOrgs {
+		writeError(w, http.StatusNotFound, "profile not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
@@ -14,11 +14,12 @@ import (
 )
 
 type OrgsHandler struct {
-	q *repo.Queries
+	q    *repo.Queries
+	pool *pgxpool.Pool
 }
 
 func NewOrgsHandler(pool *pgxpool.Pool) *OrgsHandler {
-	return &OrgsHandler{q: repo.New(pool)}
+	return &OrgsHandler{q: repo.New(pool), pool: pool}
 }
 
 func (h *OrgsHandler) Router() http.Handler {
@@ -26,6 +27,16 @@ func (h *OrgsHandler) Router() http.Handler {
 	r.Post("/", h.createOrg)             // POST /v1/orgs
 	r.Get("/", h.listMyOrgs)             // GET  /v1/orgs
 	r.Post("/{id}/members", h.addMember) // POST /v1/orgs/{id}/members
+
+	// ATS: org ilanlarına başvuran adaylar (owner/admin)
+	r.Get("/{id}/pipeline", h.getPipeline)                                        // GET  /v1/orgs/{id}/pipeline
+	r.Put("/{id}/pipeline", h.putPipeline)                                        // PUT  /v1/orgs/{id}/pipeline
+	r.Get("/{id}/jobs/{jobId}/applicants", h.listApplicants)                      // GET  /v1/orgs/{id}/jobs/{jobId}/applicants
+	r.Get("/{id}/jobs/{jobId}/applicants/{appId}", h.getApplicant)                // GET  /v1/orgs/{id}/jobs/{jobId}/applicants/{appId}
+	r.Post("/{id}/jobs/{jobId}/applicants/{appId}:move", h.moveApplicant)         // POST /v1/orgs/{id}/jobs/{jobId}/applicants/{appId}:move
+	r.Post("/{id}/jobs/{jobId}/applicants/{appId}/notes", h.createApplicantNote)  // POST /v1/orgs/{id}/jobs/{jobId}/applicants/{appId}/notes
+	r.Get("/{id}/jobs/{jobId}/applicants/{appId}/notes", h.listApplicantNotes)    // GET  /v1/orgs/{id}/jobs/{jobId}/applicants/{appId}/notes
+	r.Get("/{id}/jobs/{jobId}/applicants/{appId}/profile", h.getApplicantProfile) // GET  /v1/orgs/{id}/jobs/{jobId}/applicants/{appId}/profile
 	return r
 }
 
@@ -48,18 +59,36 @@ func (h *OrgsHandler) createOrg(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
 	defer cancel()
 
-	org, err := h.q.CreateOrganization(ctx, req.Name)
+	// org, owner üyeliği ve pipeline birlikte oluşur (yarım kalan org pipeline'sız kalmasın)
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	org, err := qtx.CreateOrganization(ctx, req.Name)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 	// kurucuyu owner yap
-	if err := h.q.AddOrgMember(ctx, repo.AddOrgMemberParams{
+	if err := qtx.AddOrgMember(ctx, repo.AddOrgMemberParams{
 		OrgID: org.ID, UserID: uid, Role: "owner",
 	}); err != nil {
 		writeError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	// varsayılan aday pipeline'ı
+	if err := qtx.SeedOrgPipeline(ctx, org.ID); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
 	writeJSON(w, http.StatusCreated, org)
 }
 
@@ -0,0 +1,546 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+const (
+	profileMaxText    = 200
+	profileMaxSummary = 10000
+	profileMaxSkills  = 100
+	profileMaxSkill   = 64
+	profileMaxEntries = 50
+	profileMaxLinks   = 20
+	maxResumeBytes    = 1 << 20 // 1 MB
+)
+
+// ProfileHandler: kullanıcının aday profili (/v1/me/profile)
+type ProfileHandler struct {
+	pool *pgxpool.Pool
+	q    *repo.Queries
+}
+
+func NewProfileHandler(pool *pgxpool.Pool) *ProfileHandler {
+	return &ProfileHandler{pool: pool, q: repo.New(pool)}
+}
+
+func (h *ProfileHandler) Router() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/profile", h.getProfile)            // GET  /v1/me/profile
+	r.Put("/profile", h.putProfile)            // PUT  /v1/me/profile
+	r.Get("/profile:export", h.exportProfile)  // GET  /v1/me/profile:export
+	r.Post("/profile:import", h.importProfile) // POST /v1/me/profile:import
+	return r
+}
+
+// ExperienceReq: iş deneyimi; tarihler YYYY, YYYY-MM veya YYYY-MM-DD, end_date boşsa devam ediyor
+type ExperienceReq struct {
+	Company   string  `json:"company"`
+	Title     string  `json:"title"`
+	Location  *string `json:"location"`
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+	Summary   *string `json:"summary"`
+}
+
+type EducationReq struct {
+	Institution string  `json:"institution"`
+	Degree      *string `json:"degree"`
+	Field       *string `json:"field"`
+	StartDate   *string `json:"start_date"`
+	EndDate     *string `json:"end_date"`
+}
+
+type LinkReq struct {
+	Label string `json:"label"` // ör. github, linkedin, website
+	URL   string `json:"url"`
+}
+
+// ProfileReq: profilin tamamı; listeler verilen sırayla saklanır.
+// share_with_orgs verilmezse mevcut değer korunur (yeni profilde false).
+type ProfileReq struct {
+	Name          *string         `json:"name"`
+	Headline      *string         `json:"headline"`
+	Location      *string         `json:"location"`
+	Summary       *string         `json:"summary"`
+	Skills        []string        `json:"skills"`
+	Experience    []ExperienceReq `json:"experience"`
+	Education     []EducationReq  `json:"education"`
+	Links         []LinkReq       `json:"links"`
+	ShareWithOrgs *bool           `json:"share_with_orgs"`
+}
+
+type profileView struct {
+	UserID        int64           `json:"user_id"`
+	Email         string          `json:"email"`
+	Name          *string         `json:"name"`
+	Headline      *string         `json:"headline"`
+	Location      *string         `json:"location"`
+	Summary       *string         `json:"summary"`
+	Skills        []string        `json:"skills"`
+	Experience    []ExperienceReq `json:"experience"`
+	Education     []EducationReq  `json:"education"`
+	Links         []LinkReq       `json:"links"`
+	ShareWithOrgs bool            `json:"share_with_orgs"` // başvurulan org ilanlarının owner/admin'leri görebilir
+	UpdatedAt     *time.Time      `json:"updated_at"`      // profil hiç kaydedilmemişse null
+}
+
+// textField: boşlukları kırpar, boşsa nil; uzunluk sınırını kontrol eder
+func textField(s *string, max int, name string) (*string, error) {
+	v := trimmedOrNil(s)
+	if v != nil && len(*v) > max {
+		return nil, fmt.Errorf("%s too long (max %d chars)", name, max)
+	}
+	return v, nil
+}
+
+// requiredText: zorunlu, kırpılmış alan
+func requiredText(s string, name string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", errors.New(name + " required")
+	}
+	if len(s) > profileMaxText {
+		return "", fmt.Errorf("%s too long (max %d chars)", name, profileMaxText)
+	}
+	return s, nil
+}
+
+// partialDate: JSON Resume tarih biçimleri (YYYY, YYYY-MM, YYYY-MM-DD)
+func partialDate(s *string, name string) (*string, error) {
+	v := trimmedOrNil(s)
+	if v == nil {
+		return nil, nil
+	}
+	layout := map[int]string{4: "2006", 7: "2006-01", 10: "2006-01-02"}[len(*v)]
+	if layout == "" {
+		return nil, errors.New("invalid " + name + " (YYYY, YYYY-MM or YYYY-MM-DD)")
+	}
+	if _, err := time.Parse(layout, *v); err != nil {
+		return nil, errors.New("invalid " + name + " (YYYY, YYYY-MM or YYYY-MM-DD)")
+	}
+	return v, nil
+}
+
+// dateRange: start/end doğrulaması; aynı önekli biçimler sözlük sırasıyla karşılaştırılabilir
+func dateRange(start, end *string) (*string, *string, error) {
+	s, err := partialDate(start, "start_date")
+	if err != nil {
+		return nil, nil, err
+	}
+	e, err := partialDate(end, "end_date")
+	if err != nil {
+		return nil, nil, err
+	}
+	if s != nil && e != nil && *e < *s {
+		return nil, nil, errors.New("end_date before start_date")
+	}
+	return s, e, nil
+}
+
+func (req ProfileReq) validate() (ProfileReq, error) {
+	f := ProfileReq{ShareWithOrgs: req.ShareWithOrgs}
+	var err error
+	if f.Name, err = textField(req.Name, profileMaxText, "name"); err != nil {
+		return f, err
+	}
+	if f.Headline, err = textField(req.Headline, profileMaxText, "headline"); err != nil {
+		return f, err
+	}
+	if f.Location, err = textField(req.Location, profileMaxText, "location"); err != nil {
+		return f, err
+	}
+	if f.Summary, err = textField(req.Summary, profileMaxSummary, "summary"); err != nil {
+		return f, err
+	}
+
+	// skills: sıra korunur, büyük/küçük harf duyarsız tekilleştirilir
+	f.Skills = []string{}
+	seen := map[string]bool{}
+	for _, s := range req.Skills {
+		s = strings.TrimSpace(s)
+		if s == "" || seen[strings.ToLower(s)] {
+			continue
+		}
+		if len(s) > profileMaxSkill {
+			return f, fmt.Errorf("skill too long (max %d chars): %s", profileMaxSkill, s)
+		}
+		seen[strings.ToLower(s)] = true
+		f.Skills = append(f.Skills, s)
+	}
+	if len(f.Skills) > profileMaxSkills {
+		return f, fmt.Errorf("max %d skills", profileMaxSkills)
+	}
+
+	if len(req.Experience) > profileMaxEntries || len(req.Education) > profileMaxEntries {
+		return f, fmt.Errorf("max %d experience / education entries", profileMaxEntries)
+	}
+	f.Experience = make([]ExperienceReq, 0, len(req.Experience))
+	for i, x := range req.Experience {
+		e, err := x.validate()
+		if err != nil {
+			return f, fmt.Errorf("experience[%d]: %w", i, err)
+		}
+		f.Experience = append(f.Experience, e)
+	}
+	f.Education = make([]EducationReq, 0, len(req.Education))
+	for i, x := range req.Education {
+		e, err := x.validate()
+		if err != nil {
+			return f, fmt.Errorf("education[%d]: %w", i, err)
+		}
+		f.Education = append(f.Education, e)
+	}
+
+	if len(req.Links) > profileMaxLinks {
+		return f, fmt.Errorf("max %d links", profileMaxLinks)
+	}
+	f.Links = make([]LinkReq, 0, len(req.Links))
+	for i, l := range req.Links {
+		label, err := requiredText(l.Label, "label")
+		if err != nil {
+			return f, fmt.Errorf("links[%d]: %w", i, err)
+		}
+		raw := strings.TrimSpace(l.URL)
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return f, fmt.Errorf("links[%d]: invalid url", i)
+		}
+		f.Links = append(f.Links, LinkReq{Label: label, URL: raw})
+	}
+	return f, nil
+}
+
+func (x ExperienceReq) validate() (ExperienceReq, error) {
+	var (
+		e   ExperienceReq
+		err error
+	)
+	if e.Company, err = requiredText(x.Company, "company"); err != nil {
+		return e, err
+	}
+	if e.Title, err = requiredText(x.Title, "title"); err != nil {
+		return e, err
+	}
+	if e.Location, err = textField(x.Location, profileMaxText, "location"); err != nil {
+		return e, err
+	}
+	if e.Summary, err = textField(x.Summary, profileMaxSummary, "summary"); err != nil {
+		return e, err
+	}
+	e.StartDate, e.EndDate, err = dateRange(x.StartDate, x.EndDate)
+	return e, err
+}
+
+func (x EducationReq) validate() (EducationReq, error) {
+	var (
+		e   EducationReq
+		err error
+	)
+	if e.Institution, err = requiredText(x.Institution, "institution"); err != nil {
+		return e, err
+	}
+	if e.Degree, err = textField(x.Degree, profileMaxText, "degree"); err != nil {
+		return e, err
+	}
+	if e.Field, err = textField(x.Field, profileMaxText, "field"); err != nil {
+		return e, err
+	}
+	e.StartDate, e.EndDate, err = dateRange(x.StartDate, x.EndDate)
+	return e, err
+}
+
+// loadProfile: profil ve alt kayıtları; hiç kaydedilmemişse boş profil (found=false)
+func loadProfile(ctx context.Context, q *repo.Queries, uid int64, email string) (profileView, bool, error) {
+	v := profileView{
+		UserID:     uid,
+		Email:      email,
+		Skills:     []string{},
+		Experience: []ExperienceReq{},
+		Education:  []EducationReq{},
+		Links:      []LinkReq{},
+	}
+	p, err := q.GetProfile(ctx, uid)
+	if err != nil {
+		if isNoRows(err) {
+			return v, false, nil
+		}
+		return v, false, err
+	}
+	v.Name, v.Headline, v.Location, v.Summary = p.Name, p.Headline, p.Location, p.Summary
+	v.ShareWithOrgs = p.ShareWithOrgs
+	v.UpdatedAt = &p.UpdatedAt
+	if p.Skills != nil {
+		v.Skills = p.Skills
+	}
+
+	exps, err := q.ListProfileExperiences(ctx, uid)
+	if err != nil {
+		return v, true, err
+	}
+	for _, x := range exps {
+		v.Experience = append(v.Experience, ExperienceReq{
+			Company:   x.Company,
+			Title:     x.Title,
+			Location:  x.Location,
+			StartDate: x.StartDate,
+			EndDate:   x.EndDate,
+			Summary:   x.Summary,
+		})
+	}
+	edus, err := q.ListProfileEducations(ctx, uid)
+	if err != nil {
+		return v, true, err
+	}
+	for _, x := range edus {
+		v.Education = append(v.Education, EducationReq{
+			Institution: x.Institution,
+			Degree:      x.Degree,
+			Field:       x.Field,
+			StartDate:   x.StartDate,
+			EndDate:     x.EndDate,
+		})
+	}
+	links, err := q.ListProfileLinks(ctx, uid)
+	if err != nil {
+		return v, true, err
+	}
+	for _, x := range links {
+		v.Links = append(v.Links, LinkReq{Label: x.Label, URL: x.Url})
+	}
+	return v, true, nil
+}
+
+// loadOwnProfile: oturumdaki kullanıcının profili (e-posta users tablosundan)
+func (h *ProfileHandler) loadOwnProfile(ctx context.Context, w http.ResponseWriter, uid int64) (profileView, bool) {
+	u, err := h.q.GetUserByID(ctx, uid)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return profileView{}, false
+	}
+	v, _, err := loadProfile(ctx, h.q, uid, u.Email)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return profileView{}, false
+	}
+	return v, true
+}
+
+// saveProfile: profili tek transaction'da bütün olarak yazar (req doğrulanmış olmalı)
+func (h *ProfileHandler) saveProfile(ctx context.Context, uid int64, req ProfileReq) error {
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := h.q.WithTx(tx)
+
+	share := false
+	if req.ShareWithOrgs != nil {
+		share = *req.ShareWithOrgs
+	} else if cur, err := qtx.GetProfile(ctx, uid); err == nil {
+		share = cur.ShareWithOrgs
+	} else if !isNoRows(err) {
+		return err
+	}
+
+	if _, err := qtx.UpsertProfile(ctx, repo.UpsertProfileParams{
+		UserID:        uid,
+		Name:          req.Name,
+		Headline:      req.Headline,
+		Location:      req.Location,
+		Summary:       req.Summary,
+		Skills:        req.Skills,
+		ShareWithOrgs: share,
+	}); err != nil {
+		return err
+	}
+	if err := qtx.ClearProfileEntries(ctx, uid); err != nil {
+		return err
+	}
+	for i, x := range req.Experience {
+		if err := qtx.CreateProfileExperience(ctx, repo.CreateProfileExperienceParams{
+			UserID:    uid,
+			Position:  int32(i),
+			Company:   x.Company,
+			Title:     x.Title,
+			Location:  x.Location,
+			StartDate: x.StartDate,
+			EndDate:   x.EndDate,
+			Summary:   x.Summary,
+		}); err != nil {
+			return err
+		}
+	}
+	for i, x := range req.Education {
+		if err := qtx.CreateProfileEducation(ctx, repo.CreateProfileEducationParams{
+			UserID:      uid,
+			Position:    int32(i),
+			Institution: x.Institution,
+			Degree:      x.Degree,
+			Field:       x.Field,
+			StartDate:   x.StartDate,
+			EndDate:     x.EndDate,
+		}); err != nil {
+			return err
+		}
+	}
+	for i, l := range req.Links {
+		if err := qtx.CreateProfileLink(ctx, repo.CreateProfileLinkParams{
+			UserID:   uid,
+			Position: int32(i),
+			Label:    l.Label,
+			Url:      l.URL,
+		}); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// @Summary      Get my profile
+// @Description  Aday profili. Hiç kaydedilmemişse boş profil döner (updated_at null).
+// @Tags         profile
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  profileView
+// @Router       /v1/me/profile [get]
+func (h *ProfileHandler) getProfile(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	v, ok := h.loadOwnProfile(ctx, w, uid)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, v)
+}
+
+// @Summary      Replace my profile
+// @Description  Profilin tamamını değiştirir; experience / education / links verilen sırayla saklanır.
+// @Description  share_with_orgs=true: başvurduğunuz org ilanlarının owner/admin'leri profili görür
+// @Description  (sadece o başvuru üzerinden). Varsayılan false; verilmezse mevcut değer korunur.
+// @Tags         profile
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      ProfileReq  true  "profil"
+// @Success      200   {object}  profileView
+// @Failure      400   {object}  map[string]string
+// @Router       /v1/me/profile [put]
+func (h *ProfileHandler) putProfile(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req ProfileReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	h.replaceProfile(w, r, uid, req)
+}
+
+// replaceProfile: doğrula, kaydet, güncel profili döndür (PUT ve import ortak)
+func (h *ProfileHandler) replaceProfile(w http.ResponseWriter, r *http.Request, uid int64, req ProfileReq) {
+	f, err := req.validate()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := h.saveProfile(ctx, uid, f); err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	v, ok := h.loadOwnProfile(ctx, w, uid)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, v)
+}
+
+// @Summary      Export profile as JSON Resume
+// @Description  Profili JSON Resume (https://jsonresume.org/schema) biçiminde döner:
+// @Description  basics, work, education, skills. "website" etiketli link basics.url olur, diğerleri basics.profiles.
+// @Tags         profile
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  jsonResume
+// @Router       /v1/me/profile:export [get]
+func (h *ProfileHandler) exportProfile(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	v, ok := h.loadOwnProfile(ctx, w, uid)
+	if !ok {
+		return
+	}
+	w.Header().Set("Content-Disposition", `attachment; filename="resume.json"`)
+	writeJSON(w, http.StatusOK, resumeFromProfile(v))
+}
+
+// @Summary      Import profile from JSON Resume
+// @Description  JSON Resume belgesiyle profilin tamamını değiştirir (PUT ile aynı doğrulama).
+// @Description  Eşlenen alanlar: basics (name, label, summary, location, url, profiles), work, education, skills;
+// @Description  diğer bölümler (awards, projects, ...) yok sayılır. share_with_orgs değişmez.
+// @Tags         profile
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      jsonResume  true  "JSON Resume"
+// @Success      200   {object}  profileView
+// @Failure      400   {object}  map[string]string
+// @Failure      413   {object}  map[string]string
+// @Router       /v1/me/profile:import [post]
+func (h *ProfileHandler) importProfile(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	// JSON Resume'un eşlenmeyen bölümleri olabilir; bilinmeyen alanlar reddedilmez
+	var doc jsonResume
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxResumeBytes)).Decode(&doc); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "payload too large")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	h.replaceProfile(w, r, uid, doc.profileReq())
+}
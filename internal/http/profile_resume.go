@@ -0,0 +1,203 @@
+package httpx
+
+import (
+	"strings"
+)
+
+const jsonResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// jsonResume: JSON Resume belgesinin profil ile eşlenen kısmı
+type jsonResume struct {
+	Schema    string            `json:"$schema,omitempty"`
+	Basics    resumeBasics      `json:"basics"`
+	Work      []resumeWork      `json:"work"`
+	Education []resumeEducation `json:"education"`
+	Skills    []resumeSkill     `json:"skills"`
+}
+
+type resumeBasics struct {
+	Name     string          `json:"name,omitempty"`
+	Label    string          `json:"label,omitempty"` // headline
+	Email    string          `json:"email,omitempty"` // sadece export; hesap e-postası değişmez
+	URL      string          `json:"url,omitempty"`
+	Summary  string          `json:"summary,omitempty"`
+	Location *resumeLocation `json:"location,omitempty"`
+	Profiles []resumeProfile `json:"profiles"`
+}
+
+type resumeLocation struct {
+	Address     string `json:"address,omitempty"`
+	PostalCode  string `json:"postalCode,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+type resumeProfile struct {
+	Network  string `json:"network,omitempty"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+type resumeWork struct {
+	Name       string   `json:"name,omitempty"`
+	Company    string   `json:"company,omitempty"` // eski şema sürümleri; sadece import
+	Position   string   `json:"position,omitempty"`
+	Location   string   `json:"location,omitempty"`
+	StartDate  string   `json:"startDate,omitempty"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Summary    string   `json:"summary,omitempty"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+type resumeEducation struct {
+	Institution string `json:"institution,omitempty"`
+	Area        string `json:"area,omitempty"`
+	StudyType   string `json:"studyType,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+	EndDate     string `json:"endDate,omitempty"`
+}
+
+type resumeSkill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+func strOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func strOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// profileReq: JSON Resume -> ProfileReq; doğrulama validate() ile yapılır
+func (d jsonResume) profileReq() ProfileReq {
+	b := d.Basics
+	req := ProfileReq{
+		Name:       strOrNil(b.Name),
+		Headline:   strOrNil(b.Label),
+		Summary:    strOrNil(b.Summary),
+		Skills:     []string{},
+		Experience: []ExperienceReq{},
+		Education:  []EducationReq{},
+		Links:      []LinkReq{},
+	}
+	if l := b.Location; l != nil {
+		var parts []string
+		for _, p := range []string{l.City, l.Region, l.CountryCode} {
+			if p = strings.TrimSpace(p); p != "" {
+				parts = append(parts, p)
+			}
+		}
+		req.Location = strOrNil(strings.Join(parts, ", "))
+	}
+	if b.URL != "" {
+		req.Links = append(req.Links, LinkReq{Label: "website", URL: b.URL})
+	}
+	for _, p := range b.Profiles {
+		if p.URL == "" {
+			continue // sadece kullanıcı adı olan profil link olarak saklanamaz
+		}
+		label := p.Network
+		if label == "" {
+			label = "profile"
+		}
+		req.Links = append(req.Links, LinkReq{Label: label, URL: p.URL})
+	}
+
+	for _, x := range d.Work {
+		company := x.Name
+		if company == "" {
+			company = x.Company
+		}
+		// highlights ayrı alan olarak tutulmaz; özetin sonuna madde olarak eklenir
+		summary := x.Summary
+		for _, hl := range x.Highlights {
+			if hl = strings.TrimSpace(hl); hl != "" {
+				if summary != "" {
+					summary += "\n"
+				}
+				summary += "- " + hl
+			}
+		}
+		req.Experience = append(req.Experience, ExperienceReq{
+			Company:   company,
+			Title:     x.Position,
+			Location:  strOrNil(x.Location),
+			StartDate: strOrNil(x.StartDate),
+			EndDate:   strOrNil(x.EndDate),
+			Summary:   strOrNil(summary),
+		})
+	}
+	for _, x := range d.Education {
+		req.Education = append(req.Education, EducationReq{
+			Institution: x.Institution,
+			Degree:      strOrNil(x.StudyType),
+			Field:       strOrNil(x.Area),
+			StartDate:   strOrNil(x.StartDate),
+			EndDate:     strOrNil(x.EndDate),
+		})
+	}
+	for _, s := range d.Skills {
+		req.Skills = append(req.Skills, s.Name)
+	}
+	return req
+}
+
+// resumeFromProfile: profil -> JSON Resume
+func resumeFromProfile(v profileView) jsonResume {
+	d := jsonResume{
+		Schema: jsonResumeSchema,
+		Basics: resumeBasics{
+			Name:     strOrEmpty(v.Name),
+			Label:    strOrEmpty(v.Headline),
+			Email:    v.Email,
+			Summary:  strOrEmpty(v.Summary),
+			Profiles: []resumeProfile{},
+		},
+		Work:      []resumeWork{},
+		Education: []resumeEducation{},
+		Skills:    []resumeSkill{},
+	}
+	if v.Location != nil {
+		d.Basics.Location = &resumeLocation{City: *v.Location}
+	}
+	for _, l := range v.Links {
+		if d.Basics.URL == "" && strings.EqualFold(l.Label, "website") {
+			d.Basics.URL = l.URL
+			continue
+		}
+		d.Basics.Profiles = append(d.Basics.Profiles, resumeProfile{Network: l.Label, URL: l.URL})
+	}
+	for _, x := range v.Experience {
+		d.Work = append(d.Work, resumeWork{
+			Name:      x.Company,
+			Position:  x.Title,
+			Location:  strOrEmpty(x.Location),
+			StartDate: strOrEmpty(x.StartDate),
+			EndDate:   strOrEmpty(x.EndDate),
+			Summary:   strOrEmpty(x.Summary),
+		})
+	}
+	for _, x := range v.Education {
+		d.Education = append(d.Education, resumeEducation{
+			Institution: x.Institution,
+			Area:        strOrEmpty(x.Field),
+			StudyType:   strOrEmpty(x.Degree),
+			StartDate:   strOrEmpty(x.StartDate),
+			EndDate:     strOrEmpty(x.EndDate),
+		})
+	}
+	for _, s := range v.Skills {
+		d.Skills = append(d.Skills, resumeSkill{Name: s})
+	}
+	return d
+}
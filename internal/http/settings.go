@@ -0,0 +1,135 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+const (
+	ghostingMinDays = 1
+	ghostingMaxDays = 365
+)
+
+var ghostingModes = map[string]bool{"off": true, "flag": true, "transition": true}
+
+// SettingsHandler: kullanıcıya özel ayarlar (şimdilik ghosting tespiti)
+type SettingsHandler struct {
+	q *repo.Queries
+}
+
+func NewSettingsHandler(pool *pgxpool.Pool) *SettingsHandler {
+	return &SettingsHandler{q: repo.New(pool)}
+}
+
+func (h *SettingsHandler) Router() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/ghosting", h.getGhosting) // GET /v1/settings/ghosting
+	r.Put("/ghosting", h.putGhosting) // PUT /v1/settings/ghosting
+	return r
+}
+
+// GhostingSettingsReq: mode off | flag | transition; inactivity_days 1-365
+type GhostingSettingsReq struct {
+	Mode           string `json:"mode"`
+	InactivityDays int32  `json:"inactivity_days"`
+}
+
+type ghostingSettingsView struct {
+	Mode             string     `json:"mode"`
+	InactivityDays   int32      `json:"inactivity_days"`
+	LastDigestSentAt *time.Time `json:"last_digest_sent_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// @Summary      Get ghosting settings
+// @Description  Hareketsiz başvuru tespiti: inactivity_days boyunca hareket görmeyen başvurular
+// @Description  transition modunda ghosted aşamasına taşınır, flag modunda sadece işaretlenir.
+// @Description  Ayar hiç kaydedilmemişse varsayılanlar döner (flag, 30 gün); taşıma için transition açıkça seçilmelidir.
+// @Tags         settings
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  ghostingSettingsView
+// @Router       /v1/settings/ghosting [get]
+func (h *SettingsHandler) getGhosting(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	s, err := h.q.GetUserSettings(ctx, uid)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, ghostingSettingsView{
+		Mode:             s.GhostingMode,
+		InactivityDays:   s.GhostingDays,
+		LastDigestSentAt: s.GhostDigestSentAt,
+		UpdatedAt:        s.UpdatedAt,
+	})
+}
+
+// @Summary      Update ghosting settings
+// @Description  mode: off | flag | transition. inactivity_days: 1-365.
+// @Description  Tek bir başvuruyu hariç tutmak için: PATCH /v1/applications/{id} {"ghosting_opt_out": true}
+// @Tags         settings
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      GhostingSettingsReq  true  "ayarlar"
+// @Success      200   {object}  ghostingSettingsView
+// @Failure      400   {object}  map[string]string
+// @Router       /v1/settings/ghosting [put]
+func (h *SettingsHandler) putGhosting(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req GhostingSettingsReq
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	if !ghostingModes[req.Mode] {
+		writeError(w, http.StatusBadRequest, "invalid mode (off, flag, transition)")
+		return
+	}
+	if req.InactivityDays < ghostingMinDays || req.InactivityDays > ghostingMaxDays {
+		writeError(w, http.StatusBadRequest, "inactivity_days must be between 1 and 365")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+
+	s, err := h.q.UpsertGhostingSettings(ctx, repo.UpsertGhostingSettingsParams{
+		UserID:       uid,
+		GhostingMode: req.Mode,
+		GhostingDays: req.InactivityDays,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, ghostingSettingsView{
+		Mode:             s.GhostingMode,
+		InactivityDays:   s.GhostingDays,
+		LastDigestSentAt: s.GhostDigestSentAt,
+		UpdatedAt:        s.UpdatedAt,
+	})
+}
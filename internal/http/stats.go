@@ -0,0 +1,301 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+var (
+	// funnel'da ilerleme sayılmayan çıkış aşamaları
+	statsExitStatuses = []string{"rejected", "withdrawn", "ghosted"}
+	// bu aşamalara geçiş firmadan gelen bir yanıt sayılmaz
+	statsNoResponseStatuses = []string{"saved", "applied", "withdrawn", "ghosted"}
+)
+
+const (
+	statsDefaultActivityWeeks = 12
+	statsMaxActivityRange     = 2 * 366 * 24 * time.Hour
+)
+
+// StatsHandler: başvuru ve event'ler üzerinden SQL ile hesaplanan iş arama metrikleri
+type StatsHandler struct {
+	q *repo.Queries
+}
+
+func NewStatsHandler(pool *pgxpool.Pool) *StatsHandler {
+	return &StatsHandler{q: repo.New(pool)}
+}
+
+func (h *StatsHandler) Router() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/funnel", h.funnel)                // GET /v1/stats/funnel
+	r.Get("/response-times", h.responseTimes) // GET /v1/stats/response-times
+	r.Get("/activity", h.activity)            // GET /v1/stats/activity
+	return r
+}
+
+// parseStatsRange: from/to query parametreleri (RFC3339 veya YYYY-MM-DD). Sadece tarih
+// verilen to o günü kapsar. Verilmeyen uç nil döner.
+func parseStatsRange(r *http.Request) (from, to *time.Time, err error) {
+	parse := func(name string, endOfDay bool) (*time.Time, error) {
+		s := r.URL.Query().Get(name)
+		if s == "" {
+			return nil, nil
+		}
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return &t, nil
+		}
+		t, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			return nil, errors.New("invalid " + name + " (RFC3339 or YYYY-MM-DD)")
+		}
+		if endOfDay {
+			t = t.AddDate(0, 0, 1)
+		}
+		return &t, nil
+	}
+	if from, err = parse("from", false); err != nil {
+		return nil, nil, err
+	}
+	if to, err = parse("to", true); err != nil {
+		return nil, nil, err
+	}
+	if from != nil && to != nil && !to.After(*from) {
+		return nil, nil, errors.New("to must be after from")
+	}
+	return from, to, nil
+}
+
+// ratio: b == 0 ise nil; 4 haneye yuvarlanır
+func ratio(a, b int64) *float64 {
+	if b == 0 {
+		return nil
+	}
+	v := math.Round(float64(a)/float64(b)*10000) / 10000
+	return &v
+}
+
+type funnelStage struct {
+	Status             string   `json:"status"`
+	Reached            int64    `json:"reached"`              // bu aşamaya veya ilerisine ulaşan
+	Current            int64    `json:"current"`              // şu an bu aşamada
+	ConversionFromPrev *float64 `json:"conversion_from_prev"` // önceki aşamadan bu aşamaya
+	ConversionFromTop  *float64 `json:"conversion_from_top"`  // ilk aşamadan bu aşamaya
+}
+
+type funnelExit struct {
+	Status  string `json:"status"`
+	Current int64  `json:"current"`
+}
+
+// @Summary      Funnel
+// @Description  Aşama bazında ulaşan başvuru sayısı ve aşamalar arası dönüşüm oranı.
+// @Description  Bir aşamayı atlayan (örn. applied → interview) başvuru atlanan aşamaya da ulaşmış sayılır.
+// @Description  rejected / withdrawn / ghosted funnel dışında, exits altında listelenir.
+// @Tags         stats
+// @Security     BearerAuth
+// @Produce      json
+// @Param        from  query  string  false  "başvuru oluşturma zamanı >= (RFC3339 veya YYYY-MM-DD)"
+// @Param        to    query  string  false  "başvuru oluşturma zamanı < (YYYY-MM-DD ise o gün dahil)"
+// @Success      200   {object}  map[string]any
+// @Failure      400   {object}  map[string]string
+// @Router       /v1/stats/funnel [get]
+func (h *StatsHandler) funnel(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	from, to, err := parseStatsRange(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	rows, err := h.q.StatsFunnel(ctx, repo.StatsFunnelParams{
+		UserID:       uid,
+		From:         from,
+		To:           to,
+		ExitStatuses: statsExitStatuses,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	stages := []funnelStage{}
+	exits := []funnelExit{}
+	var total int64
+	for _, row := range rows {
+		total += row.Current
+		if !row.InFunnel {
+			exits = append(exits, funnelExit{Status: row.Status, Current: row.Current})
+			continue
+		}
+		st := funnelStage{Status: row.Status, Reached: row.Reached, Current: row.Current}
+		if n := len(stages); n > 0 {
+			st.ConversionFromPrev = ratio(row.Reached, stages[n-1].Reached)
+			st.ConversionFromTop = ratio(row.Reached, stages[0].Reached)
+		}
+		stages = append(stages, st)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"from":   from,
+		"to":     to,
+		"total":  total,
+		"stages": stages,
+		"exits":  exits,
+	})
+}
+
+type responseTimeGroup struct {
+	Key          string   `json:"key,omitempty"`
+	Applied      int64    `json:"applied"`
+	Responded    int64    `json:"responded"`
+	ResponseRate *float64 `json:"response_rate"`
+	MedianDays   *float64 `json:"median_days"` // yanıt yoksa null
+}
+
+// @Summary      Response times
+// @Description  applied'a geçişten ilk firma yanıtına (screening, interview, offer, rejected...) kadar
+// @Description  geçen medyan gün; genel, şirket ve tag kırılımında. Yanıt gelmeyenler yanıt oranına dahil.
+// @Tags         stats
+// @Security     BearerAuth
+// @Produce      json
+// @Param        from  query  string  false  "başvuru oluşturma zamanı >= (RFC3339 veya YYYY-MM-DD)"
+// @Param        to    query  string  false  "başvuru oluşturma zamanı < (YYYY-MM-DD ise o gün dahil)"
+// @Success      200   {object}  map[string]any
+// @Failure      400   {object}  map[string]string
+// @Router       /v1/stats/response-times [get]
+func (h *StatsHandler) responseTimes(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	from, to, err := parseStatsRange(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	rows, err := h.q.StatsResponseTimes(ctx, repo.StatsResponseTimesParams{
+		UserID:             uid,
+		From:               from,
+		To:                 to,
+		NoResponseStatuses: statsNoResponseStatuses,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+
+	overall := responseTimeGroup{}
+	byCompany := []responseTimeGroup{}
+	byTag := []responseTimeGroup{}
+	for _, row := range rows {
+		g := responseTimeGroup{
+			Applied:      row.Applied,
+			Responded:    row.Responded,
+			ResponseRate: ratio(row.Responded, row.Applied),
+		}
+		if row.Key != nil {
+			g.Key = *row.Key
+		}
+		if row.MedianDays != nil {
+			d := math.Round(*row.MedianDays*10) / 10
+			g.MedianDays = &d
+		}
+		switch row.Dimension {
+		case "overall":
+			overall = g
+		case "company":
+			byCompany = append(byCompany, g)
+		case "tag":
+			byTag = append(byTag, g)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"from":       from,
+		"to":         to,
+		"overall":    overall,
+		"by_company": byCompany,
+		"by_tag":     byTag,
+	})
+}
+
+// @Summary      Weekly activity
+// @Description  Hafta (pazartesi, UTC) bazında yeni başvuru, status değişikliği, firma yanıtı,
+// @Description  planlanan mülakat ve teklif sayıları. Default son 12 hafta, en fazla 2 yıl.
+// @Tags         stats
+// @Security     BearerAuth
+// @Produce      json
+// @Param        from  query  string  false  "başlangıç (RFC3339 veya YYYY-MM-DD)"
+// @Param        to    query  string  false  "bitiş, hariç (YYYY-MM-DD ise o gün dahil), default şimdi"
+// @Success      200   {object}  map[string]any
+// @Failure      400   {object}  map[string]string
+// @Router       /v1/stats/activity [get]
+func (h *StatsHandler) activity(w http.ResponseWriter, r *http.Request) {
+	uid, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	fromP, toP, err := parseStatsRange(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	to := time.Now().UTC()
+	if toP != nil {
+		to = *toP
+	}
+	from := to.AddDate(0, 0, -7*statsDefaultActivityWeeks)
+	if fromP != nil {
+		from = *fromP
+	}
+	if !to.After(from) {
+		writeError(w, http.StatusBadRequest, "to must be after from")
+		return
+	}
+	if to.Sub(from) > statsMaxActivityRange {
+		writeError(w, http.StatusBadRequest, "range too large (max 2 years)")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	rows, err := h.q.StatsWeeklyActivity(ctx, repo.StatsWeeklyActivityParams{
+		NoResponseStatuses: statsNoResponseStatuses,
+		From:               from,
+		To:                 to,
+		UserID:             uid,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "db error: "+err.Error())
+		return
+	}
+	if rows == nil {
+		rows = []repo.StatsWeeklyActivityRow{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"from":  from,
+		"to":    to,
+		"weeks": rows,
+	})
+}
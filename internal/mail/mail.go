@@ -0,0 +1,126 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// sendTimeout: ctx'te deadline yoksa tek bir gönderimin (bağlantı dahil) üst sınırı
+const sendTimeout = 30 * time.Second
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string // düz metin
+}
+
+// Sender: e-posta gönderimi (testte / farklı sağlayıcıda değiştirilebilsin diye interface)
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPSender: net/smtp ile gönderir. Kullanıcı adı boşsa AUTH yapılmaz (mailhog).
+type SMTPSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPSender(host, port, user, password, from string) *SMTPSender {
+	s := &SMTPSender{addr: net.JoinHostPort(host, port), from: from}
+	if user != "" {
+		s.auth = smtp.PlainAuth("", user, password, host)
+	}
+	return s
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(s.from)
+	if err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("From: " + from.String() + "\r\n")
+	b.WriteString("To: " + to.String() + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	return s.send(ctx, from.Address, to.Address, []byte(b.String()))
+}
+
+// send: smtp.SendMail ctx'i dinlemez; bağlantı ctx ile kurulur ve tüm konuşma ctx'in
+// deadline'ı (yoksa sendTimeout) ile sınırlanır, ctx iptalinde bağlantı kapatılır.
+func (s *SMTPSender) send(ctx context.Context, from, to string, msg []byte) error {
+	host, _, err := net.SplitHostPort(s.addr)
+	if err != nil {
+		return err
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(sendTimeout)
+	}
+	d := net.Dialer{Deadline: deadline}
+	conn, err := d.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if s.auth != nil {
+		if err := c.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	wc, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := wc.Write(msg); err != nil {
+		return err
+	}
+	if err := wc.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
@@ -0,0 +1,95 @@
+// Package rank: kanban sıralaması için fractional indexing (LexoRank benzeri).
+//
+// Anahtarlar 0-9a-z alfabesinde string'lerdir ve bayt sırasıyla karşılaştırılır
+// (DB'de COLLATE "C"). İki anahtarın arasına her zaman yeni bir anahtar üretilebilir,
+// böylece bir öğeyi taşımak yalnızca o öğenin satırını günceller.
+// Üretilen anahtarlar '0' ile bitmez; aksi halde "x" ile "x0" arasına yer kalmazdı.
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// ErrInvalidRange: a >= b veya geçersiz anahtar
+var ErrInvalidRange = errors.New("rank: invalid range")
+
+// Between: a < sonuç < b olan anahtar. a == "" baş, b == "" son demektir.
+func Between(a, b string) (string, error) {
+	if !valid(a) || !valid(b) {
+		return "", ErrInvalidRange
+	}
+	if b != "" && a >= b {
+		return "", ErrInvalidRange
+	}
+	return midpoint(a, b), nil
+}
+
+// First: boş bir kolon için başlangıç anahtarı
+func First() string {
+	return midpoint("", "")
+}
+
+func valid(k string) bool {
+	if k == "" {
+		return true
+	}
+	if k[len(k)-1] == '0' {
+		return false
+	}
+	for i := 0; i < len(k); i++ {
+		if strings.IndexByte(digits, k[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// midpoint: a < b varsayılır; b == "" üst sınır yok demektir. a'nın eksik
+// basamakları '0' kabul edilir.
+func midpoint(a, b string) string {
+	if b != "" {
+		// ortak önek korunur
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + midpoint(rest, b[n:])
+		}
+	}
+
+	lo := 0
+	if a != "" {
+		lo = strings.IndexByte(digits, a[0])
+	}
+	hi := len(digits)
+	if b != "" {
+		hi = strings.IndexByte(digits, b[0])
+	}
+	if hi-lo > 1 {
+		return string(digits[(lo+hi+1)/2])
+	}
+	// ardışık basamaklar
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	return string(digits[lo]) + midpoint(rest, "")
+}
+
+func digitAt(k string, i int) byte {
+	if i < len(k) {
+		return k[i]
+	}
+	return '0'
+}
@@ -0,0 +1,98 @@
+package rank
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"empty bounds", "", "", "i"},
+		{"head", "", "1", "0i"},
+		{"head below zero prefix", "", "01", "00i"},
+		{"tail", "zz", "", "zzi"},
+		{"tail after last digit", "z", "", "zi"},
+		{"gap", "1", "9", "5"},
+		{"adjacent keys", "a", "b", "ai"},
+		{"adjacent multi-digit", "a1", "a2", "a1i"},
+		{"prefix", "a", "a1", "a0i"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("Between(%q, %q) error: %v", tt.a, tt.b, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Between(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+			checkBetween(t, tt.a, tt.b, got)
+		})
+	}
+}
+
+func TestBetweenInvalidRange(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{"equal", "a", "a"},
+		{"reversed", "b", "a"},
+		{"trailing zero lower", "a0", ""},
+		{"trailing zero upper", "", "b0"},
+		{"uppercase", "", "A"},
+		{"outside alphabet", "a-", "b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := Between(tt.a, tt.b); !errors.Is(err, ErrInvalidRange) {
+				t.Fatalf("Between(%q, %q) = %q, %v; want ErrInvalidRange", tt.a, tt.b, got, err)
+			}
+		})
+	}
+}
+
+// kolonun başına/sonuna veya aynı boşluğa art arda ekleme: her yeni anahtar
+// geçerli ve sıralı kalmalı
+func TestBetweenRepeated(t *testing.T) {
+	tests := []struct {
+		name  string
+		start string
+		next  func(prev string) (string, string)
+	}{
+		{"head", First(), func(prev string) (string, string) { return "", prev }},
+		{"tail", First(), func(prev string) (string, string) { return prev, "" }},
+		{"same gap", "j", func(prev string) (string, string) { return "i", prev }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := tt.start
+			for i := 0; i < 500; i++ {
+				a, b := tt.next(prev)
+				got, err := Between(a, b)
+				if err != nil {
+					t.Fatalf("step %d: Between(%q, %q) error: %v", i, a, b, err)
+				}
+				checkBetween(t, a, b, got)
+				prev = got
+			}
+		})
+	}
+}
+
+func checkBetween(t *testing.T, a, b, got string) {
+	t.Helper()
+	if !valid(got) || got == "" {
+		t.Fatalf("Between(%q, %q) = %q is not a valid key", a, b, got)
+	}
+	if a != "" && got <= a {
+		t.Fatalf("Between(%q, %q) = %q, not greater than lower bound", a, b, got)
+	}
+	if b != "" && got >= b {
+		t.Fatalf("Between(%q, %q) = %q, not less than upper bound", a, b, got)
+	}
+}
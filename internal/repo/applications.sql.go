@@ -10,18 +10,37 @@ import (
 	"time"
 )
 
+const countOtherUsersJobApplications = `-- name: CountOtherUsersJobApplications :one
+SELECT count(*)
+FROM applications
+WHERE job_id = $1 AND user_id <> $2
+`
+
+type CountOtherUsersJobApplicationsParams struct {
+	JobID  int64 `json:"job_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// ilan silme / birleştirme öncesi: başka kullanıcılara ait (silinmiş dahil) başvuru sayısı
+func (q *Queries) CountOtherUsersJobApplications(ctx context.Context, arg CountOtherUsersJobApplicationsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countOtherUsersJobApplications, arg.JobID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createApplication = `-- name: CreateApplication :one
-INSERT INTO applications (job_id, user_id, status, notes, next_action_at)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, job_id, user_id, status, notes, next_action_at, created_at, updated_at
+INSERT INTO applications (job_id, user_id, status, next_action_at, board_rank)
+VALUES ($1, $2, COALESCE($3::text, 'applied'), $4, $5)
+RETURNING id, job_id, user_id, status, next_action_at, created_at, updated_at, deleted_at, reminder_sent_for, board_rank, ghosting_opt_out, ghost_detected_at
 `
 
 type CreateApplicationParams struct {
 	JobID        int64      `json:"job_id"`
 	UserID       int64      `json:"user_id"`
 	Status       *string    `json:"status"`
-	Notes        *string    `json:"notes"`
 	NextActionAt *time.Time `json:"next_action_at"`
+	BoardRank    string     `json:"board_rank"`
 }
 
 func (q *Queries) CreateApplication(ctx context.Context, arg CreateApplicationParams) (Application, error) {
@@ -29,8 +48,8 @@ func (q *Queries) CreateApplication(ctx context.Context, arg CreateApplicationPa
 		arg.JobID,
 		arg.UserID,
 		arg.Status,
-		arg.Notes,
 		arg.NextActionAt,
+		arg.BoardRank,
 	)
 	var i Application
 	err := row.Scan(
@@ -38,53 +57,218 @@ func (q *Queries) CreateApplication(ctx context.Context, arg CreateApplicationPa
 		&i.JobID,
 		&i.UserID,
 		&i.Status,
-		&i.Notes,
 		&i.NextActionAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ReminderSentFor,
+		&i.BoardRank,
+		&i.GhostingOptOut,
+		&i.GhostDetectedAt,
+	)
+	return i, err
+}
+
+const getApplicationByID = `-- name: GetApplicationByID :one
+SELECT id, job_id, user_id, status, next_action_at, created_at, updated_at, deleted_at, reminder_sent_for, board_rank, ghosting_opt_out, ghost_detected_at
+FROM applications
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+`
+
+type GetApplicationByIDParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+func (q *Queries) GetApplicationByID(ctx context.Context, arg GetApplicationByIDParams) (Application, error) {
+	row := q.db.QueryRow(ctx, getApplicationByID, arg.ID, arg.UserID)
+	var i Application
+	err := row.Scan(
+		&i.ID,
+		&i.JobID,
+		&i.UserID,
+		&i.Status,
+		&i.NextActionAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ReminderSentFor,
+		&i.BoardRank,
+		&i.GhostingOptOut,
+		&i.GhostDetectedAt,
+	)
+	return i, err
+}
+
+const getApplicationByUserJob = `-- name: GetApplicationByUserJob :one
+SELECT id, job_id, user_id, status, next_action_at, created_at, updated_at, deleted_at, reminder_sent_for, board_rank, ghosting_opt_out, ghost_detected_at
+FROM applications
+WHERE user_id = $1 AND job_id = $2 AND deleted_at IS NULL
+`
+
+type GetApplicationByUserJobParams struct {
+	UserID int64 `json:"user_id"`
+	JobID  int64 `json:"job_id"`
+}
+
+func (q *Queries) GetApplicationByUserJob(ctx context.Context, arg GetApplicationByUserJobParams) (Application, error) {
+	row := q.db.QueryRow(ctx, getApplicationByUserJob, arg.UserID, arg.JobID)
+	var i Application
+	err := row.Scan(
+		&i.ID,
+		&i.JobID,
+		&i.UserID,
+		&i.Status,
+		&i.NextActionAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ReminderSentFor,
+		&i.BoardRank,
+		&i.GhostingOptOut,
+		&i.GhostDetectedAt,
+	)
+	return i, err
+}
+
+const getApplicationForUpdate = `-- name: GetApplicationForUpdate :one
+SELECT id, job_id, user_id, status, next_action_at, created_at, updated_at, deleted_at, reminder_sent_for, board_rank, ghosting_opt_out, ghost_detected_at
+FROM applications
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+type GetApplicationForUpdateParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+func (q *Queries) GetApplicationForUpdate(ctx context.Context, arg GetApplicationForUpdateParams) (Application, error) {
+	row := q.db.QueryRow(ctx, getApplicationForUpdate, arg.ID, arg.UserID)
+	var i Application
+	err := row.Scan(
+		&i.ID,
+		&i.JobID,
+		&i.UserID,
+		&i.Status,
+		&i.NextActionAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ReminderSentFor,
+		&i.BoardRank,
+		&i.GhostingOptOut,
+		&i.GhostDetectedAt,
 	)
 	return i, err
 }
 
 const listApplicationsByUser = `-- name: ListApplicationsByUser :many
-SELECT id, job_id, user_id, status, notes, next_action_at, created_at, updated_at
+SELECT applications.id, applications.job_id, applications.user_id, applications.status, applications.next_action_at, applications.created_at, applications.updated_at, applications.deleted_at, applications.reminder_sent_for, applications.board_rank, applications.ghosting_opt_out, applications.ghost_detected_at, jobs.id, jobs.org_id, jobs.title, jobs.company, jobs.url, jobs.location, jobs.tags, jobs.created_at, jobs.updated_at, jobs.owner_id, jobs.canonical_url
 FROM applications
-WHERE user_id = $1
-  AND ($2::text IS NULL OR status = $2)
-ORDER BY created_at DESC
-LIMIT $4 OFFSET $3
+JOIN jobs ON jobs.id = applications.job_id
+WHERE applications.user_id = $1
+  AND applications.deleted_at IS NULL
+  AND ($2::text[] IS NULL OR applications.status = ANY($2::text[]))
+  AND ($3::text IS NULL OR jobs.company ILIKE '%' || $3 || '%')
+  AND ($4::text IS NULL OR $4::text = ANY(jobs.tags))
+  AND ($5::bigint[] IS NULL OR EXISTS (
+        SELECT 1 FROM label_applications
+        WHERE label_applications.application_id = applications.id
+          AND label_applications.label_id = ANY($5::bigint[])))
+  AND ($6::timestamptz IS NULL OR applications.created_at >= $6)
+  AND ($7::timestamptz IS NULL OR applications.created_at < $7)
+  AND ($8::timestamptz IS NULL OR applications.updated_at >= $8)
+  AND ($9::timestamptz IS NULL OR applications.updated_at < $9)
+  AND ($10::timestamptz IS NULL OR applications.next_action_at < $10)
+ORDER BY
+  CASE WHEN $11::text = 'updated_at'     AND NOT $12::bool THEN applications.updated_at END DESC,
+  CASE WHEN $11::text = 'updated_at'     AND $12::bool     THEN applications.updated_at END ASC,
+  CASE WHEN $11::text = 'next_action_at' AND NOT $12::bool THEN applications.next_action_at END DESC NULLS LAST,
+  CASE WHEN $11::text = 'next_action_at' AND $12::bool     THEN applications.next_action_at END ASC NULLS LAST,
+  CASE WHEN $11::text = 'company'        AND NOT $12::bool THEN lower(jobs.company) END DESC,
+  CASE WHEN $11::text = 'company'        AND $12::bool     THEN lower(jobs.company) END ASC,
+  CASE WHEN $11::text = 'board' THEN (SELECT position FROM application_stages WHERE application_stages.status = applications.status) END ASC,
+  CASE WHEN $11::text = 'board' THEN applications.board_rank END ASC,
+  CASE WHEN $11::text = 'board' THEN applications.id END ASC,
+  CASE WHEN $12::bool THEN applications.created_at END ASC,
+  applications.created_at DESC,
+  applications.id DESC
+LIMIT $13 OFFSET $14
 `
 
 type ListApplicationsByUserParams struct {
-	UserID int64   `json:"user_id"`
-	Status *string `json:"status"`
-	Offset int32   `json:"offset"`
-	Limit  int32   `json:"limit"`
+	UserID           int64      `json:"user_id"`
+	Statuses         []string   `json:"statuses"`
+	Company          *string    `json:"company"`
+	Tag              *string    `json:"tag"`
+	LabelIds         []int64    `json:"label_ids"`
+	CreatedAfter     *time.Time `json:"created_after"`
+	CreatedBefore    *time.Time `json:"created_before"`
+	UpdatedAfter     *time.Time `json:"updated_after"`
+	UpdatedBefore    *time.Time `json:"updated_before"`
+	NextActionBefore *time.Time `json:"next_action_before"`
+	Sort             string     `json:"sort"`
+	Asc              bool       `json:"asc"`
+	Limit            int32      `json:"limit"`
+	Offset           int32      `json:"offset"`
 }
 
-func (q *Queries) ListApplicationsByUser(ctx context.Context, arg ListApplicationsByUserParams) ([]Application, error) {
+type ListApplicationsByUserRow struct {
+	Application Application `json:"application"`
+	Job         Job         `json:"job"`
+}
+
+// ilan bilgisi tek sorguda join edilir (expand=job için N+1 yok); sort: created_at|updated_at|next_action_at|company|board
+// board: kanban sırası (aşama sırası, sonra kolon içi board_rank); order yok sayılır
+func (q *Queries) ListApplicationsByUser(ctx context.Context, arg ListApplicationsByUserParams) ([]ListApplicationsByUserRow, error) {
 	rows, err := q.db.Query(ctx, listApplicationsByUser,
 		arg.UserID,
-		arg.Status,
-		arg.Offset,
+		arg.Statuses,
+		arg.Company,
+		arg.Tag,
+		arg.LabelIds,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.UpdatedAfter,
+		arg.UpdatedBefore,
+		arg.NextActionBefore,
+		arg.Sort,
+		arg.Asc,
 		arg.Limit,
+		arg.Offset,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Application
+	var items []ListApplicationsByUserRow
 	for rows.Next() {
-		var i Application
+		var i ListApplicationsByUserRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.JobID,
-			&i.UserID,
-			&i.Status,
-			&i.Notes,
-			&i.NextActionAt,
-			&i.CreatedAt,
-			&i.UpdatedAt,
+			&i.Application.ID,
+			&i.Application.JobID,
+			&i.Application.UserID,
+			&i.Application.Status,
+			&i.Application.NextActionAt,
+			&i.Application.CreatedAt,
+			&i.Application.UpdatedAt,
+			&i.Application.DeletedAt,
+			&i.Application.ReminderSentFor,
+			&i.Application.BoardRank,
+			&i.Application.GhostingOptOut,
+			&i.Application.GhostDetectedAt,
+			&i.Job.ID,
+			&i.Job.OrgID,
+			&i.Job.Title,
+			&i.Job.Company,
+			&i.Job.Url,
+			&i.Job.Location,
+			&i.Job.Tags,
+			&i.Job.CreatedAt,
+			&i.Job.UpdatedAt,
+			&i.Job.OwnerID,
+			&i.Job.CanonicalUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -96,31 +280,186 @@ func (q *Queries) ListApplicationsByUser(ctx context.Context, arg ListApplicatio
 	return items, nil
 }
 
+const moveApplicationsToJob = `-- name: MoveApplicationsToJob :many
+UPDATE applications
+SET job_id = $1, updated_at = now()
+WHERE job_id = $2
+  AND user_id = $3
+  AND deleted_at IS NULL
+RETURNING id
+`
+
+type MoveApplicationsToJobParams struct {
+	TargetID int64 `json:"target_id"`
+	SourceID int64 `json:"source_id"`
+	UserID   int64 `json:"user_id"`
+}
+
+// ilan birleştirmede sadece çağıranın aktif başvuruları taşınır; taşınan id'ler event için döner
+func (q *Queries) MoveApplicationsToJob(ctx context.Context, arg MoveApplicationsToJobParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, moveApplicationsToJob, arg.TargetID, arg.SourceID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const softDeleteApplication = `-- name: SoftDeleteApplication :execrows
+UPDATE applications
+SET deleted_at = now(), updated_at = now()
+WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+`
+
+type SoftDeleteApplicationParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+func (q *Queries) SoftDeleteApplication(ctx context.Context, arg SoftDeleteApplicationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteApplication, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteDuplicateApplications = `-- name: SoftDeleteDuplicateApplications :many
+UPDATE applications s
+SET deleted_at = now(), updated_at = now()
+WHERE s.job_id = $1
+  AND s.user_id = $2
+  AND s.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM applications t
+    WHERE t.job_id = $3 AND t.user_id = s.user_id AND t.deleted_at IS NULL
+  )
+RETURNING s.id
+`
+
+type SoftDeleteDuplicateApplicationsParams struct {
+	SourceID int64 `json:"source_id"`
+	UserID   int64 `json:"user_id"`
+	TargetID int64 `json:"target_id"`
+}
+
+// ilan birleştirmede: çağıranın hedef ilana zaten aktif başvurusu varsa kaynaktaki başvurusu;
+// silinen id'ler event için döner
+func (q *Queries) SoftDeleteDuplicateApplications(ctx context.Context, arg SoftDeleteDuplicateApplicationsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, softDeleteDuplicateApplications, arg.SourceID, arg.UserID, arg.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateApplication = `-- name: UpdateApplication :one
+UPDATE applications
+SET
+  next_action_at   = CASE WHEN $1::bool THEN $2::timestamptz ELSE next_action_at END,
+  job_id           = COALESCE($3::bigint, job_id),
+  ghosting_opt_out = COALESCE($4::boolean, ghosting_opt_out),
+  updated_at       = now()
+WHERE id = $5 AND user_id = $6 AND deleted_at IS NULL
+RETURNING id, job_id, user_id, status, next_action_at, created_at, updated_at, deleted_at, reminder_sent_for, board_rank, ghosting_opt_out, ghost_detected_at
+`
+
+type UpdateApplicationParams struct {
+	SetNextActionAt bool       `json:"set_next_action_at"`
+	NextActionAt    *time.Time `json:"next_action_at"`
+	JobID           *int64     `json:"job_id"`
+	GhostingOptOut  *bool      `json:"ghosting_opt_out"`
+	ID              int64      `json:"id"`
+	UserID          int64      `json:"user_id"`
+}
+
+// set_* bayrağı false olan alan olduğu gibi kalır; true ise verilen değer (NULL dahil) yazılır
+func (q *Queries) UpdateApplication(ctx context.Context, arg UpdateApplicationParams) (Application, error) {
+	row := q.db.QueryRow(ctx, updateApplication,
+		arg.SetNextActionAt,
+		arg.NextActionAt,
+		arg.JobID,
+		arg.GhostingOptOut,
+		arg.ID,
+		arg.UserID,
+	)
+	var i Application
+	err := row.Scan(
+		&i.ID,
+		&i.JobID,
+		&i.UserID,
+		&i.Status,
+		&i.NextActionAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ReminderSentFor,
+		&i.BoardRank,
+		&i.GhostingOptOut,
+		&i.GhostDetectedAt,
+	)
+	return i, err
+}
+
 const updateApplicationStatus = `-- name: UpdateApplicationStatus :one
 UPDATE applications
-SET status = $1, updated_at = now()
-WHERE id = $2 AND user_id = $3
-RETURNING id, job_id, user_id, status, notes, next_action_at, created_at, updated_at
+SET status = $1, board_rank = $2, updated_at = now()
+WHERE id = $3 AND user_id = $4 AND deleted_at IS NULL
+RETURNING id, job_id, user_id, status, next_action_at, created_at, updated_at, deleted_at, reminder_sent_for, board_rank, ghosting_opt_out, ghost_detected_at
 `
 
 type UpdateApplicationStatusParams struct {
-	Status string `json:"status"`
-	ID     int64  `json:"id"`
-	UserID int64  `json:"user_id"`
+	Status    string `json:"status"`
+	BoardRank string `json:"board_rank"`
+	ID        int64  `json:"id"`
+	UserID    int64  `json:"user_id"`
 }
 
+// board_rank: yeni kolondaki yeri (bkz. GetBoardTopRank)
 func (q *Queries) UpdateApplicationStatus(ctx context.Context, arg UpdateApplicationStatusParams) (Application, error) {
-	row := q.db.QueryRow(ctx, updateApplicationStatus, arg.Status, arg.ID, arg.UserID)
+	row := q.db.QueryRow(ctx, updateApplicationStatus,
+		arg.Status,
+		arg.BoardRank,
+		arg.ID,
+		arg.UserID,
+	)
 	var i Application
 	err := row.Scan(
 		&i.ID,
 		&i.JobID,
 		&i.UserID,
 		&i.Status,
-		&i.Notes,
 		&i.NextActionAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ReminderSentFor,
+		&i.BoardRank,
+		&i.GhostingOptOut,
+		&i.GhostDetectedAt,
 	)
 	return i, err
 }
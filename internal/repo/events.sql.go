@@ -7,25 +7,28 @@ package repo
 
 import (
 	"context"
+	"encoding/json"
 )
 
 const createEvent = `-- name: CreateEvent :one
-INSERT INTO events (user_id, application_id, type, payload_json)
-VALUES ($1, $2, $3, $4)
-RETURNING id, user_id, application_id, type, payload_json, created_at
+INSERT INTO events (user_id, application_id, contact_id, type, payload_json)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, user_id, application_id, type, payload_json, created_at, contact_id
 `
 
 type CreateEventParams struct {
-	UserID        *int64 `json:"user_id"`
-	ApplicationID *int64 `json:"application_id"`
-	Type          string `json:"type"`
-	PayloadJson   []byte `json:"payload_json"`
+	UserID        *int64          `json:"user_id"`
+	ApplicationID *int64          `json:"application_id"`
+	ContactID     *int64          `json:"contact_id"`
+	Type          string          `json:"type"`
+	PayloadJson   json.RawMessage `json:"payload_json"`
 }
 
 func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error) {
 	row := q.db.QueryRow(ctx, createEvent,
 		arg.UserID,
 		arg.ApplicationID,
+		arg.ContactID,
 		arg.Type,
 		arg.PayloadJson,
 	)
@@ -37,6 +40,91 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event
 		&i.Type,
 		&i.PayloadJson,
 		&i.CreatedAt,
+		&i.ContactID,
 	)
 	return i, err
 }
+
+const listApplicationEventsByType = `-- name: ListApplicationEventsByType :many
+SELECT id, user_id, application_id, type, payload_json, created_at, contact_id
+FROM events
+WHERE application_id = $1
+  AND type = ANY($2::text[])
+ORDER BY created_at, id
+`
+
+type ListApplicationEventsByTypeParams struct {
+	ApplicationID int64    `json:"application_id"`
+	Types         []string `json:"types"`
+}
+
+func (q *Queries) ListApplicationEventsByType(ctx context.Context, arg ListApplicationEventsByTypeParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listApplicationEventsByType, arg.ApplicationID, arg.Types)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ApplicationID,
+			&i.Type,
+			&i.PayloadJson,
+			&i.CreatedAt,
+			&i.ContactID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listContactEvents = `-- name: ListContactEvents :many
+SELECT id, user_id, application_id, type, payload_json, created_at, contact_id
+FROM events
+WHERE contact_id = $1
+ORDER BY COALESCE((payload_json->>'occurred_at')::timestamptz, created_at) DESC, id DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListContactEventsParams struct {
+	ContactID int64 `json:"contact_id"`
+	Limit     int32 `json:"limit"`
+	Offset    int32 `json:"offset"`
+}
+
+// etkileşimler gerçekleştiği zamana göre (occurred_at yoksa kayıt zamanı), yeniden eskiye
+func (q *Queries) ListContactEvents(ctx context.Context, arg ListContactEventsParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listContactEvents, arg.ContactID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ApplicationID,
+			&i.Type,
+			&i.PayloadJson,
+			&i.CreatedAt,
+			&i.ContactID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -10,26 +10,30 @@ import (
 )
 
 const createJob = `-- name: CreateJob :one
-INSERT INTO jobs (org_id, title, company, url, location, tags)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, org_id, title, company, url, location, tags, created_at, updated_at
+INSERT INTO jobs (org_id, owner_id, title, company, url, canonical_url, location, tags)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, org_id, title, company, url, location, tags, created_at, updated_at, owner_id, canonical_url
 `
 
 type CreateJobParams struct {
-	OrgID    *int64   `json:"org_id"`
-	Title    string   `json:"title"`
-	Company  string   `json:"company"`
-	Url      *string  `json:"url"`
-	Location *string  `json:"location"`
-	Tags     []string `json:"tags"`
+	OrgID        *int64   `json:"org_id"`
+	OwnerID      *int64   `json:"owner_id"`
+	Title        string   `json:"title"`
+	Company      string   `json:"company"`
+	Url          *string  `json:"url"`
+	CanonicalUrl *string  `json:"canonical_url"`
+	Location     *string  `json:"location"`
+	Tags         []string `json:"tags"`
 }
 
 func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (Job, error) {
 	row := q.db.QueryRow(ctx, createJob,
 		arg.OrgID,
+		arg.OwnerID,
 		arg.Title,
 		arg.Company,
 		arg.Url,
+		arg.CanonicalUrl,
 		arg.Location,
 		arg.Tags,
 	)
@@ -44,6 +48,8 @@ func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (Job, erro
 		&i.Tags,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.CanonicalUrl,
 	)
 	return i, err
 }
@@ -58,8 +64,59 @@ func (q *Queries) DeleteJob(ctx context.Context, id int64) error {
 	return err
 }
 
+const findSimilarJob = `-- name: FindSimilarJob :one
+SELECT jobs.id, jobs.org_id, jobs.title, jobs.company, jobs.url, jobs.location, jobs.tags, jobs.created_at, jobs.updated_at, jobs.owner_id, jobs.canonical_url,
+       similarity(company || ' ' || title, $1::text || ' ' || $2::text)::float8 AS score
+FROM jobs
+WHERE owner_id = $3
+  AND (company || ' ' || title) % ($1::text || ' ' || $2::text)
+  AND similarity(company || ' ' || title, $1::text || ' ' || $2::text) >= $4::float8
+ORDER BY score DESC
+LIMIT 1
+`
+
+type FindSimilarJobParams struct {
+	Company   string  `json:"company"`
+	Title     string  `json:"title"`
+	OwnerID   int64   `json:"owner_id"`
+	Threshold float64 `json:"threshold"`
+}
+
+type FindSimilarJobRow struct {
+	Job   Job     `json:"job"`
+	Score float64 `json:"score"`
+}
+
+// company + title trigram benzerliği en yüksek olan (eşik üstü) ilan.
+// % operatörü idx_jobs_company_title_trgm'i kullanır (pg_trgm.similarity_threshold, default 0.3);
+// threshold bu değerin altında olmamalı, kesin eşik similarity() ile uygulanır.
+func (q *Queries) FindSimilarJob(ctx context.Context, arg FindSimilarJobParams) (FindSimilarJobRow, error) {
+	row := q.db.QueryRow(ctx, findSimilarJob,
+		arg.Company,
+		arg.Title,
+		arg.OwnerID,
+		arg.Threshold,
+	)
+	var i FindSimilarJobRow
+	err := row.Scan(
+		&i.Job.ID,
+		&i.Job.OrgID,
+		&i.Job.Title,
+		&i.Job.Company,
+		&i.Job.Url,
+		&i.Job.Location,
+		&i.Job.Tags,
+		&i.Job.CreatedAt,
+		&i.Job.UpdatedAt,
+		&i.Job.OwnerID,
+		&i.Job.CanonicalUrl,
+		&i.Score,
+	)
+	return i, err
+}
+
 const getJobByID = `-- name: GetJobByID :one
-SELECT id, org_id, title, company, url, location, tags, created_at, updated_at
+SELECT id, org_id, title, company, url, location, tags, created_at, updated_at, owner_id, canonical_url
 FROM jobs
 WHERE id = $1
 `
@@ -77,30 +134,141 @@ func (q *Queries) GetJobByID(ctx context.Context, id int64) (Job, error) {
 		&i.Tags,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.CanonicalUrl,
+	)
+	return i, err
+}
+
+const getJobByIDForUpdate = `-- name: GetJobByIDForUpdate :one
+SELECT id, org_id, title, company, url, location, tags, created_at, updated_at, owner_id, canonical_url
+FROM jobs
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetJobByIDForUpdate(ctx context.Context, id int64) (Job, error) {
+	row := q.db.QueryRow(ctx, getJobByIDForUpdate, id)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.Title,
+		&i.Company,
+		&i.Url,
+		&i.Location,
+		&i.Tags,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.CanonicalUrl,
+	)
+	return i, err
+}
+
+const getJobByOwnerCanonicalURL = `-- name: GetJobByOwnerCanonicalURL :one
+SELECT id, org_id, title, company, url, location, tags, created_at, updated_at, owner_id, canonical_url
+FROM jobs
+WHERE owner_id = $1 AND canonical_url = $2
+`
+
+type GetJobByOwnerCanonicalURLParams struct {
+	OwnerID      int64  `json:"owner_id"`
+	CanonicalUrl string `json:"canonical_url"`
+}
+
+func (q *Queries) GetJobByOwnerCanonicalURL(ctx context.Context, arg GetJobByOwnerCanonicalURLParams) (Job, error) {
+	row := q.db.QueryRow(ctx, getJobByOwnerCanonicalURL, arg.OwnerID, arg.CanonicalUrl)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.Title,
+		&i.Company,
+		&i.Url,
+		&i.Location,
+		&i.Tags,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.CanonicalUrl,
+	)
+	return i, err
+}
+
+type ImportJobsParams struct {
+	ID           int64    `json:"id"`
+	OwnerID      int64    `json:"owner_id"`
+	Title        string   `json:"title"`
+	Company      string   `json:"company"`
+	Url          *string  `json:"url"`
+	CanonicalUrl *string  `json:"canonical_url"`
+	Location     *string  `json:"location"`
+	Tags         []string `json:"tags"`
+}
+
+const getVisibleJob = `-- name: GetVisibleJob :one
+SELECT id, org_id, title, company, url, location, tags, created_at, updated_at, owner_id, canonical_url
+FROM jobs
+WHERE id = $1 AND job_visible_to(owner_id, org_id, $2)
+`
+
+type GetVisibleJobParams struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+// job_visible_to: kendi ilanları + org ilanları; başkasının kişisel ilanı bulunamaz
+func (q *Queries) GetVisibleJob(ctx context.Context, arg GetVisibleJobParams) (Job, error) {
+	row := q.db.QueryRow(ctx, getVisibleJob, arg.ID, arg.UserID)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.OrgID,
+		&i.Title,
+		&i.Company,
+		&i.Url,
+		&i.Location,
+		&i.Tags,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.CanonicalUrl,
 	)
 	return i, err
 }
 
 const listJobs = `-- name: ListJobs :many
-SELECT id, org_id, title, company, url, location, tags, created_at, updated_at
+SELECT id, org_id, title, company, url, location, tags, created_at, updated_at, owner_id, canonical_url
 FROM jobs
 WHERE ($1::text IS NULL OR company ILIKE '%' || $1 || '%')
   AND ($2::text   IS NULL OR title   ILIKE '%' || $2   || '%')
+  AND ($3::bigint[] IS NULL OR EXISTS (
+        SELECT 1 FROM label_jobs
+        JOIN labels ON labels.id = label_jobs.label_id
+        WHERE label_jobs.job_id = jobs.id
+          AND labels.user_id = $4
+          AND label_jobs.label_id = ANY($3::bigint[])))
 ORDER BY created_at DESC
-LIMIT $4 OFFSET $3
+LIMIT $6 OFFSET $5
 `
 
 type ListJobsParams struct {
-	Company *string `json:"company"`
-	Title   *string `json:"title"`
-	Offset  int32   `json:"offset"`
-	Limit   int32   `json:"limit"`
+	Company  *string `json:"company"`
+	Title    *string `json:"title"`
+	LabelIds []int64 `json:"label_ids"`
+	UserID   int64   `json:"user_id"`
+	Offset   int32   `json:"offset"`
+	Limit    int32   `json:"limit"`
 }
 
+// label_ids: isteyen kullanıcının (user_id) etiketlerinden herhangi biri
 func (q *Queries) ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, error) {
 	rows, err := q.db.Query(ctx, listJobs,
 		arg.Company,
 		arg.Title,
+		arg.LabelIds,
+		arg.UserID,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -121,6 +289,8 @@ func (q *Queries) ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, erro
 			&i.Tags,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.OwnerID,
+			&i.CanonicalUrl,
 		); err != nil {
 			return nil, err
 		}
@@ -132,34 +302,194 @@ func (q *Queries) ListJobs(ctx context.Context, arg ListJobsParams) ([]Job, erro
 	return items, nil
 }
 
+const listJobsByIDs = `-- name: ListJobsByIDs :many
+SELECT id, org_id, title, company, url, location, tags, created_at, updated_at, owner_id, canonical_url
+FROM jobs
+WHERE id = ANY($1::bigint[])
+ORDER BY id
+`
+
+func (q *Queries) ListJobsByIDs(ctx context.Context, ids []int64) ([]Job, error) {
+	rows, err := q.db.Query(ctx, listJobsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.Title,
+			&i.Company,
+			&i.Url,
+			&i.Location,
+			&i.Tags,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OwnerID,
+			&i.CanonicalUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listManagedJobsAfter = `-- name: ListManagedJobsAfter :many
+SELECT id, org_id, title, company, url, location, tags, created_at, updated_at, owner_id, canonical_url
+FROM jobs
+WHERE id > $1
+  AND (owner_id = $2
+       OR org_id IN (SELECT org_id FROM org_members WHERE user_id = $2))
+ORDER BY id
+LIMIT $3
+`
+
+type ListManagedJobsAfterParams struct {
+	AfterID int64 `json:"after_id"`
+	UserID  int64 `json:"user_id"`
+	Limit   int32 `json:"limit"`
+}
+
+// export kapsamı: kullanıcının kendi ilanları + üyesi olduğu org'ların ilanları (id ile keyset sayfalama).
+// job_visible_to'dan dar: başka org'ların herkese açık ilanları dışa aktarılmaz.
+func (q *Queries) ListManagedJobsAfter(ctx context.Context, arg ListManagedJobsAfterParams) ([]Job, error) {
+	rows, err := q.db.Query(ctx, listManagedJobsAfter, arg.AfterID, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.Title,
+			&i.Company,
+			&i.Url,
+			&i.Location,
+			&i.Tags,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OwnerID,
+			&i.CanonicalUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOwnerCanonicalURLs = `-- name: ListOwnerCanonicalURLs :many
+SELECT canonical_url::text
+FROM jobs
+WHERE owner_id = $1 AND canonical_url = ANY($2::text[])
+`
+
+type ListOwnerCanonicalURLsParams struct {
+	OwnerID int64    `json:"owner_id"`
+	Urls    []string `json:"urls"`
+}
+
+func (q *Queries) ListOwnerCanonicalURLs(ctx context.Context, arg ListOwnerCanonicalURLsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listOwnerCanonicalURLs, arg.OwnerID, arg.Urls)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var canonical_url string
+		if err := rows.Scan(&canonical_url); err != nil {
+			return nil, err
+		}
+		items = append(items, canonical_url)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reserveJobIDs = `-- name: ReserveJobIDs :many
+SELECT nextval(pg_get_serial_sequence('jobs', 'id'))::bigint AS id
+FROM generate_series(1, $1::int)
+`
+
+// COPY id döndürmez: toplu içe aktarmada id'ler önceden sequence'tan alınır
+func (q *Queries) ReserveJobIDs(ctx context.Context, count int32) ([]int64, error) {
+	rows, err := q.db.Query(ctx, reserveJobIDs, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateJob = `-- name: UpdateJob :one
 UPDATE jobs
 SET
-  title    = COALESCE($1, title),
-  company  = COALESCE($2, company),
-  url      = COALESCE($3, url),
-  location = COALESCE($4, location),
-  tags     = COALESCE($5, tags),
+  title    = CASE WHEN $1::bool    THEN $2::text    ELSE title END,
+  company  = CASE WHEN $3::bool  THEN $4::text  ELSE company END,
+  url      = CASE WHEN $5::bool      THEN $6::text     ELSE url END,
+  canonical_url = CASE WHEN $5::bool THEN $7::text ELSE canonical_url END,
+  location = CASE WHEN $8::bool THEN $9::text ELSE location END,
+  tags     = CASE WHEN $10::bool     THEN $11::text[]   ELSE tags END,
   updated_at = now()
-WHERE id = $6
-RETURNING id, org_id, title, company, url, location, tags, created_at, updated_at
+WHERE id = $12
+RETURNING id, org_id, title, company, url, location, tags, created_at, updated_at, owner_id, canonical_url
 `
 
 type UpdateJobParams struct {
-	Title    *string  `json:"title"`
-	Company  *string  `json:"company"`
-	Url      *string  `json:"url"`
-	Location *string  `json:"location"`
-	Tags     []string `json:"tags"`
-	ID       int64    `json:"id"`
+	SetTitle     bool     `json:"set_title"`
+	Title        string   `json:"title"`
+	SetCompany   bool     `json:"set_company"`
+	Company      string   `json:"company"`
+	SetUrl       bool     `json:"set_url"`
+	Url          *string  `json:"url"`
+	CanonicalUrl *string  `json:"canonical_url"`
+	SetLocation  bool     `json:"set_location"`
+	Location     *string  `json:"location"`
+	SetTags      bool     `json:"set_tags"`
+	Tags         []string `json:"tags"`
+	ID           int64    `json:"id"`
 }
 
+// set_* bayrağı false olan alan olduğu gibi kalır; true ise verilen değer (NULL dahil) yazılır
 func (q *Queries) UpdateJob(ctx context.Context, arg UpdateJobParams) (Job, error) {
 	row := q.db.QueryRow(ctx, updateJob,
+		arg.SetTitle,
 		arg.Title,
+		arg.SetCompany,
 		arg.Company,
+		arg.SetUrl,
 		arg.Url,
+		arg.CanonicalUrl,
+		arg.SetLocation,
 		arg.Location,
+		arg.SetTags,
 		arg.Tags,
 		arg.ID,
 	)
@@ -174,6 +504,8 @@ func (q *Queries) UpdateJob(ctx context.Context, arg UpdateJobParams) (Job, erro
 		&i.Tags,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.CanonicalUrl,
 	)
 	return i, err
 }
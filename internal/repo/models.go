@@ -5,39 +5,190 @@
 package repo
 
 import (
+	"encoding/json"
 	"time"
 )
 
+type ApplicantNote struct {
+	ID            int64     `json:"id"`
+	ApplicationID int64     `json:"application_id"`
+	OrgID         int64     `json:"org_id"`
+	AuthorID      *int64    `json:"author_id"`
+	Body          string    `json:"body"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type ApplicantReview struct {
+	ApplicationID  int64     `json:"application_id"`
+	OrgID          int64     `json:"org_id"`
+	Stage          string    `json:"stage"`
+	StageChangedAt time.Time `json:"stage_changed_at"`
+	StageChangedBy *int64    `json:"stage_changed_by"`
+}
+
 type Application struct {
-	ID           int64      `json:"id"`
-	JobID        int64      `json:"job_id"`
-	UserID       int64      `json:"user_id"`
-	Status       string     `json:"status"`
-	Notes        *string    `json:"notes"`
-	NextActionAt *time.Time `json:"next_action_at"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
+	ID              int64      `json:"id"`
+	JobID           int64      `json:"job_id"`
+	UserID          int64      `json:"user_id"`
+	Status          string     `json:"status"`
+	NextActionAt    *time.Time `json:"next_action_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	DeletedAt       *time.Time `json:"deleted_at"`
+	ReminderSentFor *time.Time `json:"reminder_sent_for"`
+	BoardRank       string     `json:"board_rank"`
+	GhostingOptOut  bool       `json:"ghosting_opt_out"`
+	GhostDetectedAt *time.Time `json:"ghost_detected_at"`
+}
+
+type ApplicationAttachment struct {
+	ID            int64     `json:"id"`
+	ApplicationID int64     `json:"application_id"`
+	AttachmentID  int64     `json:"attachment_id"`
+	Kind          string    `json:"kind"`
+	Filename      string    `json:"filename"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type ApplicationNote struct {
+	ID            int64      `json:"id"`
+	ApplicationID int64      `json:"application_id"`
+	AuthorID      *int64     `json:"author_id"`
+	Body          string     `json:"body"`
+	Pinned        bool       `json:"pinned"`
+	CreatedAt     time.Time  `json:"created_at"`
+	EditedAt      *time.Time `json:"edited_at"`
+}
+
+type ApplicationStage struct {
+	Status     string `json:"status"`
+	Position   int32  `json:"position"`
+	IsInitial  bool   `json:"is_initial"`
+	IsTerminal bool   `json:"is_terminal"`
+}
+
+type ApplicationTransition struct {
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+}
+
+type Attachment struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Sha256      string    `json:"sha256"`
+	SizeBytes   int64     `json:"size_bytes"`
+	ContentType string    `json:"content_type"`
+	StorageKey  string    `json:"storage_key"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CalendarToken struct {
+	UserID    int64     `json:"user_id"`
+	TokenHash string    `json:"token_hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CandidateProfile struct {
+	UserID        int64     `json:"user_id"`
+	Name          *string   `json:"name"`
+	Headline      *string   `json:"headline"`
+	Location      *string   `json:"location"`
+	Summary       *string   `json:"summary"`
+	Skills        []string  `json:"skills"`
+	ShareWithOrgs bool      `json:"share_with_orgs"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type Contact struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Name        string    `json:"name"`
+	Email       *string   `json:"email"`
+	Phone       *string   `json:"phone"`
+	LinkedinUrl *string   `json:"linkedin_url"`
+	Company     *string   `json:"company"`
+	Role        *string   `json:"role"`
+	Notes       *string   `json:"notes"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 type Event struct {
+	ID            int64           `json:"id"`
+	UserID        *int64          `json:"user_id"`
+	ApplicationID *int64          `json:"application_id"`
+	Type          string          `json:"type"`
+	PayloadJson   json.RawMessage `json:"payload_json"`
+	CreatedAt     time.Time       `json:"created_at"`
+	ContactID     *int64          `json:"contact_id"`
+}
+
+type Interview struct {
 	ID            int64     `json:"id"`
-	UserID        *int64    `json:"user_id"`
-	ApplicationID *int64    `json:"application_id"`
+	ApplicationID int64     `json:"application_id"`
+	RoundName     string    `json:"round_name"`
 	Type          string    `json:"type"`
-	PayloadJson   []byte    `json:"payload_json"`
+	StartsAt      time.Time `json:"starts_at"`
+	EndsAt        time.Time `json:"ends_at"`
+	Timezone      string    `json:"timezone"`
+	Location      *string   `json:"location"`
+	MeetingUrl    *string   `json:"meeting_url"`
+	Interviewers  []string  `json:"interviewers"`
+	Outcome       string    `json:"outcome"`
 	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 type Job struct {
-	ID        int64      `json:"id"`
-	OrgID     *int64     `json:"org_id"`
-	Title     string     `json:"title"`
-	Company   string     `json:"company"`
-	Url       *string    `json:"url"`
-	Location  *string    `json:"location"`
-	Tags      []string   `json:"tags"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt *time.Time `json:"updated_at"`
+	ID           int64      `json:"id"`
+	OrgID        *int64     `json:"org_id"`
+	Title        string     `json:"title"`
+	Company      string     `json:"company"`
+	Url          *string    `json:"url"`
+	Location     *string    `json:"location"`
+	Tags         []string   `json:"tags"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+	OwnerID      *int64     `json:"owner_id"`
+	CanonicalUrl *string    `json:"canonical_url"`
+}
+
+type JobRevision struct {
+	ID        int64           `json:"id"`
+	JobID     int64           `json:"job_id"`
+	Rev       int32           `json:"rev"`
+	UserID    *int64          `json:"user_id"`
+	Changes   json.RawMessage `json:"changes"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type Label struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Offer struct {
+	ID                  int64      `json:"id"`
+	ApplicationID       int64      `json:"application_id"`
+	Currency            string     `json:"currency"`
+	BaseSalary          int64      `json:"base_salary"`
+	SalaryPeriod        string     `json:"salary_period"`
+	Bonus               *int64     `json:"bonus"`
+	SigningBonus        *int64     `json:"signing_bonus"`
+	EquityValue         *int64     `json:"equity_value"`
+	EquityVestingMonths *int32     `json:"equity_vesting_months"`
+	EquityCliffMonths   *int32     `json:"equity_cliff_months"`
+	Benefits            []string   `json:"benefits"`
+	StartDate           *time.Time `json:"start_date"`
+	RespondBy           *time.Time `json:"respond_by"`
+	Notes               *string    `json:"notes"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
 }
 
 type OrgMember struct {
@@ -47,12 +198,46 @@ type OrgMember struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type OrgPipelineStage struct {
+	OrgID    int64  `json:"org_id"`
+	Stage    string `json:"stage"`
+	Position int32  `json:"position"`
+}
+
 type Organization struct {
 	ID        int64     `json:"id"`
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type ProfileEducation struct {
+	UserID      int64   `json:"user_id"`
+	Position    int32   `json:"position"`
+	Institution string  `json:"institution"`
+	Degree      *string `json:"degree"`
+	Field       *string `json:"field"`
+	StartDate   *string `json:"start_date"`
+	EndDate     *string `json:"end_date"`
+}
+
+type ProfileExperience struct {
+	UserID    int64   `json:"user_id"`
+	Position  int32   `json:"position"`
+	Company   string  `json:"company"`
+	Title     string  `json:"title"`
+	Location  *string `json:"location"`
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+	Summary   *string `json:"summary"`
+}
+
+type ProfileLink struct {
+	UserID   int64  `json:"user_id"`
+	Position int32  `json:"position"`
+	Label    string `json:"label"`
+	Url      string `json:"url"`
+}
+
 type RefreshToken struct {
 	ID        int64      `json:"id"`
 	UserID    int64      `json:"user_id"`
@@ -62,6 +247,14 @@ type RefreshToken struct {
 	CreatedAt time.Time  `json:"created_at"`
 }
 
+type UserSetting struct {
+	UserID            int64      `json:"user_id"`
+	GhostingMode      string     `json:"ghosting_mode"`
+	GhostingDays      int32      `json:"ghosting_days"`
+	GhostDigestSentAt *time.Time `json:"ghost_digest_sent_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
 type User struct {
 	ID           int64     `json:"id"`
 	Email        string    `json:"email"`
@@ -0,0 +1,43 @@
+-- name: CreateApplicationNote :one
+INSERT INTO application_notes (application_id, author_id, body, pinned)
+VALUES (sqlc.arg('application_id'), sqlc.narg('author_id'), sqlc.arg('body'), sqlc.arg('pinned'))
+RETURNING *;
+
+-- name: ListApplicationNotes :many
+-- sabitlenenler önce, sonra kronolojik
+SELECT *
+FROM application_notes
+WHERE application_id = sqlc.arg('application_id')
+ORDER BY pinned DESC, created_at, id;
+
+-- name: GetApplicationNote :one
+SELECT *
+FROM application_notes
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id');
+
+-- name: UpdateApplicationNote :one
+-- body değişirse edited_at güncellenir; sadece pin değişimi düzenleme sayılmaz
+UPDATE application_notes
+SET edited_at = CASE WHEN sqlc.narg('body')::text IS NOT NULL AND sqlc.narg('body')::text <> body THEN now() ELSE edited_at END,
+    body      = COALESCE(sqlc.narg('body')::text, body),
+    pinned    = COALESCE(sqlc.narg('pinned')::bool, pinned)
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id')
+RETURNING *;
+
+-- name: DeleteApplicationNote :execrows
+DELETE FROM application_notes
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id');
+
+-- name: SearchApplicationNotes :many
+-- kullanıcının tüm (silinmemiş) başvurularındaki notlarda full-text arama
+SELECT sqlc.embed(application_notes),
+       ts_headline('simple', application_notes.body, query, 'MaxFragments=2, MinWords=5, MaxWords=20')::text AS snippet,
+       ts_rank(to_tsvector('simple', application_notes.body), query)::float8 AS rank
+FROM application_notes
+JOIN applications ON applications.id = application_notes.application_id,
+     websearch_to_tsquery('simple', sqlc.arg('query')::text) AS query
+WHERE applications.user_id = sqlc.arg('user_id')
+  AND applications.deleted_at IS NULL
+  AND to_tsvector('simple', application_notes.body) @@ query
+ORDER BY rank DESC, application_notes.created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
@@ -0,0 +1,17 @@
+-- name: ListApplicationStages :many
+SELECT *
+FROM application_stages
+ORDER BY position;
+
+-- name: ListApplicationTransitions :many
+SELECT t.*
+FROM application_transitions t
+JOIN application_stages s ON s.status = t.to_status
+ORDER BY t.from_status, s.position;
+
+-- name: ListNextStatuses :many
+SELECT t.to_status
+FROM application_transitions t
+JOIN application_stages s ON s.status = t.to_status
+WHERE t.from_status = sqlc.arg('from_status')
+ORDER BY s.position;
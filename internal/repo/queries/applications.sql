@@ -1,18 +1,107 @@
 -- name: CreateApplication :one
-INSERT INTO applications (job_id, user_id, status, notes, next_action_at)
-VALUES (sqlc.arg('job_id'), sqlc.arg('user_id'), sqlc.narg('status'), sqlc.narg('notes'), sqlc.narg('next_action_at'))
+INSERT INTO applications (job_id, user_id, status, next_action_at, board_rank)
+VALUES (sqlc.arg('job_id'), sqlc.arg('user_id'), COALESCE(sqlc.narg('status')::text, 'applied'), sqlc.narg('next_action_at'), sqlc.arg('board_rank'))
 RETURNING *;
 
 -- name: ListApplicationsByUser :many
-SELECT *
+-- ilan bilgisi tek sorguda join edilir (expand=job için N+1 yok); sort: created_at|updated_at|next_action_at|company|board
+-- board: kanban sırası (aşama sırası, sonra kolon içi board_rank); order yok sayılır
+SELECT sqlc.embed(applications), sqlc.embed(jobs)
 FROM applications
-WHERE user_id = sqlc.arg('user_id')
-  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
-ORDER BY created_at DESC
+JOIN jobs ON jobs.id = applications.job_id
+WHERE applications.user_id = sqlc.arg('user_id')
+  AND applications.deleted_at IS NULL
+  AND (sqlc.narg('statuses')::text[] IS NULL OR applications.status = ANY(sqlc.narg('statuses')::text[]))
+  AND (sqlc.narg('company')::text IS NULL OR jobs.company ILIKE '%' || sqlc.narg('company') || '%')
+  AND (sqlc.narg('tag')::text IS NULL OR sqlc.narg('tag')::text = ANY(jobs.tags))
+  AND (sqlc.narg('label_ids')::bigint[] IS NULL OR EXISTS (
+        SELECT 1 FROM label_applications
+        WHERE label_applications.application_id = applications.id
+          AND label_applications.label_id = ANY(sqlc.narg('label_ids')::bigint[])))
+  AND (sqlc.narg('created_after')::timestamptz IS NULL OR applications.created_at >= sqlc.narg('created_after'))
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR applications.created_at < sqlc.narg('created_before'))
+  AND (sqlc.narg('updated_after')::timestamptz IS NULL OR applications.updated_at >= sqlc.narg('updated_after'))
+  AND (sqlc.narg('updated_before')::timestamptz IS NULL OR applications.updated_at < sqlc.narg('updated_before'))
+  AND (sqlc.narg('next_action_before')::timestamptz IS NULL OR applications.next_action_at < sqlc.narg('next_action_before'))
+ORDER BY
+  CASE WHEN sqlc.arg('sort')::text = 'updated_at'     AND NOT sqlc.arg('asc')::bool THEN applications.updated_at END DESC,
+  CASE WHEN sqlc.arg('sort')::text = 'updated_at'     AND sqlc.arg('asc')::bool     THEN applications.updated_at END ASC,
+  CASE WHEN sqlc.arg('sort')::text = 'next_action_at' AND NOT sqlc.arg('asc')::bool THEN applications.next_action_at END DESC NULLS LAST,
+  CASE WHEN sqlc.arg('sort')::text = 'next_action_at' AND sqlc.arg('asc')::bool     THEN applications.next_action_at END ASC NULLS LAST,
+  CASE WHEN sqlc.arg('sort')::text = 'company'        AND NOT sqlc.arg('asc')::bool THEN lower(jobs.company) END DESC,
+  CASE WHEN sqlc.arg('sort')::text = 'company'        AND sqlc.arg('asc')::bool     THEN lower(jobs.company) END ASC,
+  CASE WHEN sqlc.arg('sort')::text = 'board' THEN (SELECT position FROM application_stages WHERE application_stages.status = applications.status) END ASC,
+  CASE WHEN sqlc.arg('sort')::text = 'board' THEN applications.board_rank END ASC,
+  CASE WHEN sqlc.arg('sort')::text = 'board' THEN applications.id END ASC,
+  CASE WHEN sqlc.arg('asc')::bool THEN applications.created_at END ASC,
+  applications.created_at DESC,
+  applications.id DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: UpdateApplicationStatus :one
+-- board_rank: yeni kolondaki yeri (bkz. GetBoardTopRank)
+UPDATE applications
+SET status = sqlc.arg('status'), board_rank = sqlc.arg('board_rank'), updated_at = now()
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id') AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteDuplicateApplications :many
+-- ilan birleştirmede: çağıranın hedef ilana zaten aktif başvurusu varsa kaynaktaki başvurusu;
+-- silinen id'ler event için döner
+UPDATE applications s
+SET deleted_at = now(), updated_at = now()
+WHERE s.job_id = sqlc.arg('source_id')
+  AND s.user_id = sqlc.arg('user_id')
+  AND s.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM applications t
+    WHERE t.job_id = sqlc.arg('target_id') AND t.user_id = s.user_id AND t.deleted_at IS NULL
+  )
+RETURNING s.id;
+
+-- name: MoveApplicationsToJob :many
+-- ilan birleştirmede sadece çağıranın aktif başvuruları taşınır; taşınan id'ler event için döner
+UPDATE applications
+SET job_id = sqlc.arg('target_id'), updated_at = now()
+WHERE job_id = sqlc.arg('source_id')
+  AND user_id = sqlc.arg('user_id')
+  AND deleted_at IS NULL
+RETURNING id;
+
+-- name: CountOtherUsersJobApplications :one
+-- ilan silme / birleştirme öncesi: başka kullanıcılara ait (silinmiş dahil) başvuru sayısı
+SELECT count(*)
+FROM applications
+WHERE job_id = sqlc.arg('job_id') AND user_id <> sqlc.arg('user_id');
+
+-- name: GetApplicationForUpdate :one
+SELECT *
+FROM applications
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id') AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: GetApplicationByID :one
+SELECT *
+FROM applications
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id') AND deleted_at IS NULL;
+
+-- name: GetApplicationByUserJob :one
+SELECT *
+FROM applications
+WHERE user_id = sqlc.arg('user_id') AND job_id = sqlc.arg('job_id') AND deleted_at IS NULL;
+
+-- name: UpdateApplication :one
+-- set_* bayrağı false olan alan olduğu gibi kalır; true ise verilen değer (NULL dahil) yazılır
 UPDATE applications
-SET status = sqlc.arg('status'), updated_at = now()
-WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id')
+SET
+  next_action_at   = CASE WHEN sqlc.arg('set_next_action_at')::bool THEN sqlc.narg('next_action_at')::timestamptz ELSE next_action_at END,
+  job_id           = COALESCE(sqlc.narg('job_id')::bigint, job_id),
+  ghosting_opt_out = COALESCE(sqlc.narg('ghosting_opt_out')::boolean, ghosting_opt_out),
+  updated_at       = now()
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id') AND deleted_at IS NULL
 RETURNING *;
+
+-- name: SoftDeleteApplication :execrows
+UPDATE applications
+SET deleted_at = now(), updated_at = now()
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id') AND deleted_at IS NULL;
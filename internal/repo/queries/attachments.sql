@@ -0,0 +1,32 @@
+-- name: UpsertAttachment :one
+-- aynı kullanıcı aynı içeriği tekrar yüklerse mevcut kayıt döner
+INSERT INTO attachments (user_id, sha256, size_bytes, content_type, storage_key)
+VALUES (sqlc.arg('user_id'), sqlc.arg('sha256'), sqlc.arg('size_bytes'), sqlc.arg('content_type'), sqlc.arg('storage_key'))
+ON CONFLICT (user_id, sha256) DO UPDATE SET sha256 = EXCLUDED.sha256
+RETURNING *;
+
+-- name: LinkApplicationAttachment :one
+INSERT INTO application_attachments (application_id, attachment_id, kind, filename)
+VALUES (sqlc.arg('application_id'), sqlc.arg('attachment_id'), sqlc.arg('kind'), sqlc.arg('filename'))
+ON CONFLICT (application_id, attachment_id) DO UPDATE SET kind = EXCLUDED.kind, filename = EXCLUDED.filename
+RETURNING *;
+
+-- name: ListApplicationAttachments :many
+SELECT sqlc.embed(application_attachments), sqlc.embed(attachments)
+FROM application_attachments
+JOIN attachments ON attachments.id = application_attachments.attachment_id
+WHERE application_attachments.application_id = sqlc.arg('application_id')
+ORDER BY application_attachments.created_at, application_attachments.id;
+
+-- name: GetApplicationAttachment :one
+-- indirme: başvuru silinmişse link de geçersiz
+SELECT sqlc.embed(application_attachments), sqlc.embed(attachments)
+FROM application_attachments
+JOIN attachments ON attachments.id = application_attachments.attachment_id
+JOIN applications ON applications.id = application_attachments.application_id
+WHERE application_attachments.id = sqlc.arg('id')
+  AND applications.deleted_at IS NULL;
+
+-- name: UnlinkApplicationAttachment :execrows
+DELETE FROM application_attachments
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id');
@@ -0,0 +1,36 @@
+-- name: GetBoardTopRank :one
+-- kolondaki en küçük rank, kolon boşsa boş string; yeni/taşınan kart bunun önüne konur
+SELECT COALESCE(min(board_rank), '')::text AS board_rank
+FROM applications
+WHERE user_id = sqlc.arg('user_id') AND status = sqlc.arg('status') AND deleted_at IS NULL;
+
+-- name: GetBoardPrev :one
+-- kolonda (rank, id) sırasına göre verilen konumdan hemen önceki kart
+SELECT *
+FROM applications
+WHERE user_id = sqlc.arg('user_id') AND status = sqlc.arg('status') AND deleted_at IS NULL
+  AND id <> sqlc.arg('exclude_id')
+  AND (board_rank, id) < (sqlc.arg('board_rank')::text COLLATE "C", sqlc.arg('id')::bigint)
+ORDER BY board_rank DESC, id DESC
+LIMIT 1;
+
+-- name: GetBoardNext :one
+-- kolonda (rank, id) sırasına göre verilen konumdan hemen sonraki kart
+SELECT *
+FROM applications
+WHERE user_id = sqlc.arg('user_id') AND status = sqlc.arg('status') AND deleted_at IS NULL
+  AND id <> sqlc.arg('exclude_id')
+  AND (board_rank, id) > (sqlc.arg('board_rank')::text COLLATE "C", sqlc.arg('id')::bigint)
+ORDER BY board_rank, id
+LIMIT 1;
+
+-- name: LockBoardColumn :exec
+-- kolonun rank'ını okuyup yazan işlemleri transaction sonuna kadar sıralar;
+-- aynı en üst rank'in iki karta verilmesini önler (unique index yedek güvence)
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg('user_id')::bigint::text || ':' || sqlc.arg('status')::text, 0));
+
+-- name: MoveApplication :one
+UPDATE applications
+SET status = sqlc.arg('status'), board_rank = sqlc.arg('board_rank'), updated_at = now()
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id') AND deleted_at IS NULL
+RETURNING *;
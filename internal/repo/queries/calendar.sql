@@ -0,0 +1,42 @@
+-- name: UpsertCalendarToken :one
+INSERT INTO calendar_tokens (user_id, token_hash)
+VALUES (sqlc.arg('user_id'), sqlc.arg('token_hash'))
+ON CONFLICT (user_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash, created_at = now()
+RETURNING *;
+
+-- name: DeleteCalendarToken :execrows
+DELETE FROM calendar_tokens
+WHERE user_id = sqlc.arg('user_id');
+
+-- name: GetCalendarTokenByHash :one
+SELECT *
+FROM calendar_tokens
+WHERE token_hash = sqlc.arg('token_hash');
+
+-- name: ListCalendarNextActions :many
+-- takvim feed'i: next_action_at'i olan aktif başvurular
+SELECT applications.id, applications.status,
+       applications.next_action_at::timestamptz AS next_action_at,
+       applications.updated_at,
+       jobs.title, jobs.company, jobs.url
+FROM applications
+JOIN jobs ON jobs.id = applications.job_id
+WHERE applications.user_id = sqlc.arg('user_id')
+  AND applications.deleted_at IS NULL
+  AND applications.next_action_at IS NOT NULL
+ORDER BY applications.next_action_at;
+
+-- name: ListCalendarInterviews :many
+-- takvim feed'i: iptal edilmemiş mülakatlar
+SELECT interviews.id, interviews.application_id, interviews.round_name, interviews.type,
+       interviews.starts_at, interviews.ends_at, interviews.location, interviews.meeting_url,
+       interviews.interviewers, interviews.updated_at,
+       jobs.title, jobs.company
+FROM interviews
+JOIN applications ON applications.id = interviews.application_id
+JOIN jobs ON jobs.id = applications.job_id
+WHERE applications.user_id = sqlc.arg('user_id')
+  AND applications.deleted_at IS NULL
+  AND interviews.outcome <> 'cancelled'
+ORDER BY interviews.starts_at;
@@ -0,0 +1,87 @@
+-- name: CreateContact :one
+INSERT INTO contacts (user_id, name, email, phone, linkedin_url, company, role, notes)
+VALUES (sqlc.arg('user_id'), sqlc.arg('name'), sqlc.narg('email'), sqlc.narg('phone'), sqlc.narg('linkedin_url'),
+        sqlc.narg('company'), sqlc.narg('role'), sqlc.narg('notes'))
+RETURNING *;
+
+-- name: GetContact :one
+SELECT *
+FROM contacts
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id');
+
+-- name: ListContacts :many
+-- q: ad, e-posta, şirket, rol ve notlarda arama; job_id / application_id: o kayda bağlı kişiler
+SELECT *
+FROM contacts
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('q')::text IS NULL
+       OR name ILIKE '%' || sqlc.narg('q') || '%'
+       OR email ILIKE '%' || sqlc.narg('q') || '%'
+       OR company ILIKE '%' || sqlc.narg('q') || '%'
+       OR role ILIKE '%' || sqlc.narg('q') || '%'
+       OR notes ILIKE '%' || sqlc.narg('q') || '%')
+  AND (sqlc.narg('company')::text IS NULL OR company ILIKE '%' || sqlc.narg('company') || '%')
+  AND (sqlc.narg('job_id')::bigint IS NULL
+       OR id IN (SELECT contact_id FROM contact_jobs WHERE job_id = sqlc.narg('job_id')))
+  AND (sqlc.narg('application_id')::bigint IS NULL
+       OR id IN (SELECT contact_id FROM contact_applications WHERE application_id = sqlc.narg('application_id')))
+ORDER BY name, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: UpdateContact :one
+-- tüm alanlar yazılır; merge handler'da mevcut kayıt üzerinde yapılır
+UPDATE contacts
+SET name         = sqlc.arg('name'),
+    email        = sqlc.narg('email'),
+    phone        = sqlc.narg('phone'),
+    linkedin_url = sqlc.narg('linkedin_url'),
+    company      = sqlc.narg('company'),
+    role         = sqlc.narg('role'),
+    notes        = sqlc.narg('notes'),
+    updated_at   = now()
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id')
+RETURNING *;
+
+-- name: DeleteContact :execrows
+DELETE FROM contacts
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id');
+
+-- name: LinkContactJob :execrows
+INSERT INTO contact_jobs (contact_id, job_id)
+VALUES (sqlc.arg('contact_id'), sqlc.arg('job_id'))
+ON CONFLICT DO NOTHING;
+
+-- name: UnlinkContactJob :execrows
+DELETE FROM contact_jobs
+WHERE contact_id = sqlc.arg('contact_id') AND job_id = sqlc.arg('job_id');
+
+-- name: CopyContactJobs :execrows
+-- ilan birleştirmede: kopya ilana bağlı kişiler hayatta kalan ilana (kopya silinince cascade)
+INSERT INTO contact_jobs (contact_id, job_id, created_at)
+SELECT contact_id, sqlc.arg('target_id'), created_at
+FROM contact_jobs
+WHERE job_id = sqlc.arg('source_id')
+ON CONFLICT DO NOTHING;
+
+-- name: LinkContactApplication :execrows
+INSERT INTO contact_applications (contact_id, application_id)
+VALUES (sqlc.arg('contact_id'), sqlc.arg('application_id'))
+ON CONFLICT DO NOTHING;
+
+-- name: UnlinkContactApplication :execrows
+DELETE FROM contact_applications
+WHERE contact_id = sqlc.arg('contact_id') AND application_id = sqlc.arg('application_id');
+
+-- name: ListContactJobIDs :many
+SELECT job_id
+FROM contact_jobs
+WHERE contact_id = sqlc.arg('contact_id')
+ORDER BY created_at, job_id;
+
+-- name: ListContactApplicationIDs :many
+-- soft delete edilmiş başvurular gösterilmez
+SELECT ca.application_id
+FROM contact_applications ca
+JOIN applications a ON a.id = ca.application_id
+WHERE ca.contact_id = sqlc.arg('contact_id') AND a.deleted_at IS NULL
+ORDER BY ca.created_at, ca.application_id;
@@ -1,4 +1,19 @@
 -- name: CreateEvent :one
-INSERT INTO events (user_id, application_id, type, payload_json)
-VALUES (sqlc.narg('user_id'), sqlc.narg('application_id'), sqlc.arg('type'), sqlc.arg('payload_json'))
+INSERT INTO events (user_id, application_id, contact_id, type, payload_json)
+VALUES (sqlc.narg('user_id'), sqlc.narg('application_id'), sqlc.narg('contact_id'), sqlc.arg('type'), sqlc.arg('payload_json'))
 RETURNING *;
+
+-- name: ListApplicationEventsByType :many
+SELECT *
+FROM events
+WHERE application_id = sqlc.arg('application_id')
+  AND type = ANY(sqlc.arg('types')::text[])
+ORDER BY created_at, id;
+
+-- name: ListContactEvents :many
+-- etkileşimler gerçekleştiği zamana göre (occurred_at yoksa kayıt zamanı), yeniden eskiye
+SELECT *
+FROM events
+WHERE contact_id = sqlc.arg('contact_id')
+ORDER BY COALESCE((payload_json->>'occurred_at')::timestamptz, created_at) DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
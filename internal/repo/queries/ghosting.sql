@@ -0,0 +1,72 @@
+-- name: ClaimStaleApplication :one
+-- Son aktivitesi (updated_at veya sistem dışı son event) kullanıcının eşiğinden eski,
+-- ghosted'a geçebilen aşamadaki sıradaki başvuru. Opt-out edilenler ve son tespitten
+-- sonra aktivitesi olmayanlar atlanır. SKIP LOCKED: birden fazla worker aynı satırı almaz.
+SELECT applications.id, applications.user_id, applications.status,
+       COALESCE(user_settings.ghosting_mode, 'flag')::text AS ghosting_mode,
+       COALESCE(user_settings.ghosting_days, 30)::int AS ghosting_days,
+       activity.last_activity_at::timestamptz AS last_activity_at
+FROM applications
+LEFT JOIN user_settings ON user_settings.user_id = applications.user_id
+CROSS JOIN LATERAL (
+  SELECT GREATEST(applications.updated_at,
+                  (SELECT max(events.created_at) FROM events
+                   WHERE events.application_id = applications.id
+                     AND events.type <> ALL(sqlc.arg('system_event_types')::text[]))) AS last_activity_at
+) AS activity
+WHERE applications.deleted_at IS NULL
+  AND NOT applications.ghosting_opt_out
+  AND COALESCE(user_settings.ghosting_mode, 'flag') <> 'off'
+  AND EXISTS (SELECT 1 FROM application_transitions
+              WHERE application_transitions.from_status = applications.status
+                AND application_transitions.to_status = 'ghosted')
+  AND activity.last_activity_at < now() - make_interval(days => COALESCE(user_settings.ghosting_days, 30))
+  AND (applications.ghost_detected_at IS NULL OR applications.ghost_detected_at < activity.last_activity_at)
+  AND NOT (applications.id = ANY(sqlc.arg('skip_ids')::bigint[]))
+ORDER BY activity.last_activity_at
+LIMIT 1
+FOR UPDATE OF applications SKIP LOCKED;
+
+-- name: MarkGhostDetected :exec
+-- updated_at'e dokunulmaz (aktivite sayılmasın)
+UPDATE applications
+SET ghost_detected_at = now()
+WHERE id = sqlc.arg('id');
+
+-- name: ClaimGhostDigestUser :one
+-- son 7 günde (veya son özetten beri) tespit olan, haftalık özet zamanı gelmiş sıradaki kullanıcı
+SELECT users.id, users.email, user_settings.ghost_digest_sent_at
+FROM users
+LEFT JOIN user_settings ON user_settings.user_id = users.id
+WHERE (user_settings.ghost_digest_sent_at IS NULL OR user_settings.ghost_digest_sent_at <= now() - interval '7 days')
+  AND EXISTS (SELECT 1 FROM events
+              WHERE events.user_id = users.id
+                AND events.type = sqlc.arg('detected_type')
+                AND events.created_at > COALESCE(user_settings.ghost_digest_sent_at, now() - interval '7 days'))
+  AND NOT (users.id = ANY(sqlc.arg('skip_ids')::bigint[]))
+ORDER BY users.id
+LIMIT 1
+FOR UPDATE OF users SKIP LOCKED;
+
+-- name: ListGhostDigestItems :many
+SELECT events.application_id::bigint AS application_id, events.created_at AS detected_at,
+       events.payload_json, applications.status, jobs.title, jobs.company
+FROM events
+JOIN applications ON applications.id = events.application_id
+JOIN jobs ON jobs.id = applications.job_id
+WHERE events.user_id = sqlc.arg('user_id')
+  AND events.type = sqlc.arg('detected_type')
+  AND events.created_at > sqlc.arg('since')
+  AND applications.deleted_at IS NULL
+ORDER BY events.created_at, events.id;
+
+-- name: MarkGhostDigestSent :exec
+INSERT INTO user_settings (user_id, ghost_digest_sent_at)
+VALUES (sqlc.arg('user_id'), now())
+ON CONFLICT (user_id) DO UPDATE SET ghost_digest_sent_at = now();
+
+-- name: RestoreGhostDigestSentAt :exec
+-- özet gönderilemezse önceki değer geri yazılır; sonraki turda tekrar denenir
+UPDATE user_settings
+SET ghost_digest_sent_at = sqlc.narg('sent_at')
+WHERE user_id = sqlc.arg('user_id');
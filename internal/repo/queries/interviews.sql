@@ -0,0 +1,42 @@
+-- name: CreateInterview :one
+INSERT INTO interviews (application_id, round_name, type, starts_at, ends_at, timezone, location, meeting_url, interviewers, outcome)
+VALUES (sqlc.arg('application_id'), sqlc.arg('round_name'), sqlc.arg('type'), sqlc.arg('starts_at'), sqlc.arg('ends_at'),
+        sqlc.arg('timezone'), sqlc.narg('location'), sqlc.narg('meeting_url'), sqlc.arg('interviewers'), sqlc.arg('outcome'))
+RETURNING *;
+
+-- name: ListInterviewsByApplication :many
+SELECT *
+FROM interviews
+WHERE application_id = sqlc.arg('application_id')
+ORDER BY starts_at, id;
+
+-- name: GetInterview :one
+SELECT *
+FROM interviews
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id');
+
+-- name: CountInterviewsByApplication :one
+-- iptal edilen turlar sayılmaz (ilk gerçek tur otomatik ilerletmeyi tetikler)
+SELECT count(*)
+FROM interviews
+WHERE application_id = sqlc.arg('application_id') AND outcome <> 'cancelled';
+
+-- name: UpdateInterview :one
+-- tüm alanlar yazılır; merge handler'da mevcut kayıt üzerinde yapılır
+UPDATE interviews
+SET round_name   = sqlc.arg('round_name'),
+    type         = sqlc.arg('type'),
+    starts_at    = sqlc.arg('starts_at'),
+    ends_at      = sqlc.arg('ends_at'),
+    timezone     = sqlc.arg('timezone'),
+    location     = sqlc.narg('location'),
+    meeting_url  = sqlc.narg('meeting_url'),
+    interviewers = sqlc.arg('interviewers'),
+    outcome      = sqlc.arg('outcome'),
+    updated_at   = now()
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id')
+RETURNING *;
+
+-- name: DeleteInterview :execrows
+DELETE FROM interviews
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id');
@@ -0,0 +1,24 @@
+-- name: CreateJobRevision :one
+INSERT INTO job_revisions (job_id, rev, user_id, changes)
+VALUES (
+  sqlc.arg('job_id'),
+  (SELECT COALESCE(MAX(rev), 0) + 1 FROM job_revisions WHERE job_id = sqlc.arg('job_id')),
+  sqlc.narg('user_id'),
+  sqlc.arg('changes')
+)
+RETURNING *;
+
+-- name: CreateJobRevisions :copyfrom
+INSERT INTO job_revisions (job_id, rev, user_id, changes)
+VALUES (sqlc.arg('job_id'), sqlc.arg('rev'), sqlc.narg('user_id'), sqlc.arg('changes'));
+
+-- name: ListJobRevisions :many
+SELECT *
+FROM job_revisions
+WHERE job_id = sqlc.arg('job_id')
+ORDER BY rev;
+
+-- name: GetJobRevision :one
+SELECT *
+FROM job_revisions
+WHERE job_id = sqlc.arg('job_id') AND rev = sqlc.arg('rev');
@@ -1,6 +1,6 @@
 -- name: CreateJob :one
-INSERT INTO jobs (org_id, title, company, url, location, tags)
-VALUES (sqlc.narg('org_id'), sqlc.arg('title'), sqlc.arg('company'), sqlc.narg('url'), sqlc.narg('location'), sqlc.arg('tags'))
+INSERT INTO jobs (org_id, owner_id, title, company, url, canonical_url, location, tags)
+VALUES (sqlc.narg('org_id'), sqlc.narg('owner_id'), sqlc.arg('title'), sqlc.arg('company'), sqlc.narg('url'), sqlc.narg('canonical_url'), sqlc.narg('location'), sqlc.arg('tags'))
 RETURNING *;
 
 -- name: GetJobByID :one
@@ -9,21 +9,30 @@ FROM jobs
 WHERE id = sqlc.arg('id');
 
 -- name: ListJobs :many
+-- label_ids: isteyen kullanıcının (user_id) etiketlerinden herhangi biri
 SELECT *
 FROM jobs
 WHERE (sqlc.narg('company')::text IS NULL OR company ILIKE '%' || sqlc.narg('company') || '%')
   AND (sqlc.narg('title')::text   IS NULL OR title   ILIKE '%' || sqlc.narg('title')   || '%')
+  AND (sqlc.narg('label_ids')::bigint[] IS NULL OR EXISTS (
+        SELECT 1 FROM label_jobs
+        JOIN labels ON labels.id = label_jobs.label_id
+        WHERE label_jobs.job_id = jobs.id
+          AND labels.user_id = sqlc.arg('user_id')
+          AND label_jobs.label_id = ANY(sqlc.narg('label_ids')::bigint[])))
 ORDER BY created_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: UpdateJob :one
+-- set_* bayrağı false olan alan olduğu gibi kalır; true ise verilen değer (NULL dahil) yazılır
 UPDATE jobs
 SET
-  title    = COALESCE(sqlc.narg('title'), title),
-  company  = COALESCE(sqlc.narg('company'), company),
-  url      = COALESCE(sqlc.narg('url'), url),
-  location = COALESCE(sqlc.narg('location'), location),
-  tags     = COALESCE(sqlc.narg('tags'), tags),
+  title    = CASE WHEN sqlc.arg('set_title')::bool    THEN sqlc.arg('title')::text    ELSE title END,
+  company  = CASE WHEN sqlc.arg('set_company')::bool  THEN sqlc.arg('company')::text  ELSE company END,
+  url      = CASE WHEN sqlc.arg('set_url')::bool      THEN sqlc.narg('url')::text     ELSE url END,
+  canonical_url = CASE WHEN sqlc.arg('set_url')::bool THEN sqlc.narg('canonical_url')::text ELSE canonical_url END,
+  location = CASE WHEN sqlc.arg('set_location')::bool THEN sqlc.narg('location')::text ELSE location END,
+  tags     = CASE WHEN sqlc.arg('set_tags')::bool     THEN sqlc.arg('tags')::text[]   ELSE tags END,
   updated_at = now()
 WHERE id = sqlc.arg('id')
 RETURNING *;
@@ -31,3 +40,64 @@ RETURNING *;
 -- name: DeleteJob :exec
 DELETE FROM jobs
 WHERE id = sqlc.arg('id');
+
+-- name: GetJobByOwnerCanonicalURL :one
+SELECT *
+FROM jobs
+WHERE owner_id = sqlc.arg('owner_id') AND canonical_url = sqlc.arg('canonical_url');
+
+-- name: GetVisibleJob :one
+-- job_visible_to: kendi ilanları + org ilanları; başkasının kişisel ilanı bulunamaz
+SELECT *
+FROM jobs
+WHERE id = sqlc.arg('id') AND job_visible_to(owner_id, org_id, sqlc.arg('user_id'));
+
+-- name: FindSimilarJob :one
+-- company + title trigram benzerliği en yüksek olan (eşik üstü) ilan.
+-- % operatörü idx_jobs_company_title_trgm'i kullanır (pg_trgm.similarity_threshold, default 0.3);
+-- threshold bu değerin altında olmamalı, kesin eşik similarity() ile uygulanır.
+SELECT sqlc.embed(jobs),
+       similarity(company || ' ' || title, sqlc.arg('company')::text || ' ' || sqlc.arg('title')::text)::float8 AS score
+FROM jobs
+WHERE owner_id = sqlc.arg('owner_id')
+  AND (company || ' ' || title) % (sqlc.arg('company')::text || ' ' || sqlc.arg('title')::text)
+  AND similarity(company || ' ' || title, sqlc.arg('company')::text || ' ' || sqlc.arg('title')::text) >= sqlc.arg('threshold')::float8
+ORDER BY score DESC
+LIMIT 1;
+
+-- name: ReserveJobIDs :many
+-- COPY id döndürmez: toplu içe aktarmada id'ler önceden sequence'tan alınır
+SELECT nextval(pg_get_serial_sequence('jobs', 'id'))::bigint AS id
+FROM generate_series(1, sqlc.arg('count')::int);
+
+-- name: ImportJobs :copyfrom
+INSERT INTO jobs (id, owner_id, title, company, url, canonical_url, location, tags)
+VALUES (sqlc.arg('id'), sqlc.arg('owner_id'), sqlc.arg('title'), sqlc.arg('company'), sqlc.narg('url'), sqlc.narg('canonical_url'), sqlc.narg('location'), sqlc.arg('tags'));
+
+-- name: ListOwnerCanonicalURLs :many
+SELECT canonical_url::text
+FROM jobs
+WHERE owner_id = sqlc.arg('owner_id') AND canonical_url = ANY(sqlc.arg('urls')::text[]);
+
+-- name: ListManagedJobsAfter :many
+-- export kapsamı: kullanıcının kendi ilanları + üyesi olduğu org'ların ilanları (id ile keyset sayfalama).
+-- job_visible_to'dan dar: başka org'ların herkese açık ilanları dışa aktarılmaz.
+SELECT *
+FROM jobs
+WHERE id > sqlc.arg('after_id')
+  AND (owner_id = sqlc.arg('user_id')
+       OR org_id IN (SELECT org_id FROM org_members WHERE user_id = sqlc.arg('user_id')))
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: GetJobByIDForUpdate :one
+SELECT *
+FROM jobs
+WHERE id = sqlc.arg('id')
+FOR UPDATE;
+
+-- name: ListJobsByIDs :many
+SELECT *
+FROM jobs
+WHERE id = ANY(sqlc.arg('ids')::bigint[])
+ORDER BY id;
@@ -0,0 +1,112 @@
+-- name: CreateLabel :one
+INSERT INTO labels (user_id, name, color)
+VALUES (sqlc.arg('user_id'), sqlc.arg('name'), sqlc.arg('color'))
+RETURNING *;
+
+-- name: ListLabels :many
+-- bağlı (silinmemiş) başvuru ve ilan sayılarıyla
+SELECT sqlc.embed(labels),
+       (SELECT count(*) FROM label_applications
+        JOIN applications ON applications.id = label_applications.application_id
+        WHERE label_applications.label_id = labels.id AND applications.deleted_at IS NULL)::bigint AS application_count,
+       (SELECT count(*) FROM label_jobs WHERE label_jobs.label_id = labels.id)::bigint AS job_count
+FROM labels
+WHERE labels.user_id = sqlc.arg('user_id')
+ORDER BY lower(labels.name), labels.id;
+
+-- name: GetLabel :one
+SELECT *
+FROM labels
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id');
+
+-- name: GetLabelForUpdate :one
+SELECT *
+FROM labels
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id')
+FOR UPDATE;
+
+-- name: UpdateLabel :one
+UPDATE labels
+SET name       = sqlc.arg('name'),
+    color      = sqlc.arg('color'),
+    updated_at = now()
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id')
+RETURNING *;
+
+-- name: DeleteLabel :execrows
+DELETE FROM labels
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id');
+
+-- name: MergeLabelApplications :many
+-- kaynak etiketin başvuru bağlantılarını hedefe taşır (hedefte zaten olanlar atlanır);
+-- hedefi yeni alan başvurular döner
+INSERT INTO label_applications (label_id, application_id, created_at)
+SELECT sqlc.arg('target_id'), application_id, created_at
+FROM label_applications
+WHERE label_id = sqlc.arg('source_id')
+ON CONFLICT DO NOTHING
+RETURNING application_id;
+
+-- name: MergeLabelJobs :execrows
+INSERT INTO label_jobs (label_id, job_id, created_at)
+SELECT sqlc.arg('target_id'), job_id, created_at
+FROM label_jobs
+WHERE label_id = sqlc.arg('source_id')
+ON CONFLICT DO NOTHING;
+
+-- name: CopyJobLabels :execrows
+-- ilan birleştirmede: kopya ilanın etiketleri hayatta kalan ilana (kopya silinince cascade)
+INSERT INTO label_jobs (label_id, job_id, created_at)
+SELECT label_id, sqlc.arg('target_id'), created_at
+FROM label_jobs
+WHERE job_id = sqlc.arg('source_id')
+ON CONFLICT DO NOTHING;
+
+-- name: LinkLabelApplication :execrows
+INSERT INTO label_applications (label_id, application_id)
+VALUES (sqlc.arg('label_id'), sqlc.arg('application_id'))
+ON CONFLICT DO NOTHING;
+
+-- name: UnlinkLabelApplication :execrows
+DELETE FROM label_applications
+WHERE label_id = sqlc.arg('label_id') AND application_id = sqlc.arg('application_id');
+
+-- name: LinkLabelJob :execrows
+INSERT INTO label_jobs (label_id, job_id)
+VALUES (sqlc.arg('label_id'), sqlc.arg('job_id'))
+ON CONFLICT DO NOTHING;
+
+-- name: UnlinkLabelJob :execrows
+DELETE FROM label_jobs
+WHERE label_id = sqlc.arg('label_id') AND job_id = sqlc.arg('job_id');
+
+-- name: ListLabelApplicationIDs :many
+SELECT label_applications.application_id
+FROM label_applications
+JOIN applications ON applications.id = label_applications.application_id
+WHERE label_applications.label_id = sqlc.arg('label_id') AND applications.deleted_at IS NULL
+ORDER BY label_applications.application_id;
+
+-- name: ListLabelJobIDs :many
+SELECT job_id
+FROM label_jobs
+WHERE label_id = sqlc.arg('label_id')
+ORDER BY job_id;
+
+-- name: ListApplicationLabels :many
+-- liste / tekil başvuru yanıtlarına eklenen etiketler (N+1 yok)
+SELECT label_applications.application_id, sqlc.embed(labels)
+FROM label_applications
+JOIN labels ON labels.id = label_applications.label_id
+WHERE label_applications.application_id = ANY(sqlc.arg('application_ids')::bigint[])
+  AND labels.user_id = sqlc.arg('user_id')
+ORDER BY label_applications.application_id, lower(labels.name);
+
+-- name: ListJobLabels :many
+-- yalnızca isteyen kullanıcının etiketleri
+SELECT label_jobs.job_id, sqlc.embed(labels)
+FROM label_jobs
+JOIN labels ON labels.id = label_jobs.label_id
+WHERE label_jobs.job_id = ANY(sqlc.arg('job_ids')::bigint[])
+  AND labels.user_id = sqlc.arg('user_id')
+ORDER BY label_jobs.job_id, lower(labels.name);
@@ -0,0 +1,52 @@
+-- name: CreateOffer :one
+INSERT INTO offers (application_id, currency, base_salary, salary_period, bonus, signing_bonus,
+                    equity_value, equity_vesting_months, equity_cliff_months, benefits, start_date, respond_by, notes)
+VALUES (sqlc.arg('application_id'), sqlc.arg('currency'), sqlc.arg('base_salary'), sqlc.arg('salary_period'),
+        sqlc.narg('bonus'), sqlc.narg('signing_bonus'), sqlc.narg('equity_value'), sqlc.narg('equity_vesting_months'),
+        sqlc.narg('equity_cliff_months'), sqlc.arg('benefits'), sqlc.narg('start_date'), sqlc.narg('respond_by'), sqlc.narg('notes'))
+RETURNING *;
+
+-- name: ListOffersByApplication :many
+SELECT *
+FROM offers
+WHERE application_id = sqlc.arg('application_id')
+ORDER BY created_at, id;
+
+-- name: GetOffer :one
+SELECT *
+FROM offers
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id');
+
+-- name: UpdateOffer :one
+-- tüm alanlar yazılır; merge handler'da mevcut kayıt üzerinde yapılır
+UPDATE offers
+SET currency              = sqlc.arg('currency'),
+    base_salary           = sqlc.arg('base_salary'),
+    salary_period         = sqlc.arg('salary_period'),
+    bonus                 = sqlc.narg('bonus'),
+    signing_bonus         = sqlc.narg('signing_bonus'),
+    equity_value          = sqlc.narg('equity_value'),
+    equity_vesting_months = sqlc.narg('equity_vesting_months'),
+    equity_cliff_months   = sqlc.narg('equity_cliff_months'),
+    benefits              = sqlc.arg('benefits'),
+    start_date            = sqlc.narg('start_date'),
+    respond_by            = sqlc.narg('respond_by'),
+    notes                 = sqlc.narg('notes'),
+    updated_at            = now()
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id')
+RETURNING *;
+
+-- name: DeleteOffer :execrows
+DELETE FROM offers
+WHERE id = sqlc.arg('id') AND application_id = sqlc.arg('application_id');
+
+-- name: ListOffersByUser :many
+-- kullanıcının silinmemiş başvurularındaki teklifler, ilan bilgisiyle; ids verilirse yalnızca onlar
+SELECT sqlc.embed(offers), applications.status AS application_status, jobs.title AS job_title, jobs.company AS job_company
+FROM offers
+JOIN applications ON applications.id = offers.application_id
+JOIN jobs ON jobs.id = applications.job_id
+WHERE applications.user_id = sqlc.arg('user_id')
+  AND applications.deleted_at IS NULL
+  AND (sqlc.narg('ids')::bigint[] IS NULL OR offers.id = ANY(sqlc.narg('ids')::bigint[]))
+ORDER BY offers.created_at DESC, offers.id DESC;
@@ -0,0 +1,111 @@
+-- name: SeedOrgPipeline :exec
+-- yeni org için varsayılan aday pipeline'ı
+INSERT INTO org_pipeline_stages (org_id, stage, position)
+SELECT sqlc.arg('org_id'), t.stage, t.position * 10
+FROM unnest(ARRAY['new', 'screening', 'interview', 'offer', 'hired', 'rejected']) WITH ORDINALITY AS t(stage, position)
+ON CONFLICT DO NOTHING;
+
+-- name: ListOrgPipelineStages :many
+SELECT stage
+FROM org_pipeline_stages
+WHERE org_id = sqlc.arg('org_id')
+ORDER BY position, stage;
+
+-- name: DeleteOrgPipelineStagesExcept :execrows
+-- kullanımdaki aşama silinirse FK hatası (applicant_reviews)
+DELETE FROM org_pipeline_stages
+WHERE org_id = sqlc.arg('org_id') AND NOT (stage = ANY(sqlc.arg('stages')::text[]));
+
+-- name: PinImplicitApplicantStages :execrows
+-- pipeline değişmeden önce: review satırı olmayan (ilk aşamada sayılan) adaylar o aşamaya
+-- yazılır; ilk aşama değişince sessizce yeni ilk aşamaya kaymasınlar
+INSERT INTO applicant_reviews (application_id, org_id, stage, stage_changed_at)
+SELECT applications.id, jobs.org_id, sqlc.arg('stage'), applications.created_at
+FROM applications
+JOIN jobs ON jobs.id = applications.job_id
+WHERE jobs.org_id = sqlc.arg('org_id')
+  AND applications.deleted_at IS NULL
+  AND applications.status <> 'saved'
+  AND NOT EXISTS (SELECT 1 FROM applicant_reviews WHERE applicant_reviews.application_id = applications.id)
+ON CONFLICT (application_id) DO NOTHING;
+
+-- name: UpsertOrgPipelineStages :exec
+INSERT INTO org_pipeline_stages (org_id, stage, position)
+SELECT sqlc.arg('org_id'), t.stage, t.position * 10
+FROM unnest(sqlc.arg('stages')::text[]) WITH ORDINALITY AS t(stage, position)
+ON CONFLICT (org_id, stage) DO UPDATE SET position = EXCLUDED.position;
+
+-- name: GetOrgJob :one
+SELECT *
+FROM jobs
+WHERE id = sqlc.arg('id') AND org_id = sqlc.arg('org_id');
+
+-- name: ListJobApplicants :many
+-- adayın kendi status'u org'a gösterilmez; saved (henüz başvurmamış) ve silinen başvurular hariç.
+-- review satırı olmayan başvuru pipeline'ın ilk aşamasındadır.
+SELECT applications.id AS application_id, applications.created_at AS applied_at,
+       users.id AS candidate_id, users.email AS candidate_email,
+       COALESCE(applicant_reviews.stage, first_stage.stage)::text AS stage,
+       applicant_reviews.stage_changed_at,
+       (SELECT count(*) FROM applicant_notes
+        WHERE applicant_notes.application_id = applications.id AND applicant_notes.org_id = jobs.org_id)::bigint AS note_count
+FROM applications
+JOIN jobs ON jobs.id = applications.job_id
+JOIN users ON users.id = applications.user_id
+LEFT JOIN applicant_reviews ON applicant_reviews.application_id = applications.id AND applicant_reviews.org_id = jobs.org_id
+LEFT JOIN LATERAL (
+  SELECT stage FROM org_pipeline_stages
+  WHERE org_pipeline_stages.org_id = jobs.org_id
+  ORDER BY position, stage
+  LIMIT 1
+) AS first_stage ON true
+WHERE jobs.id = sqlc.arg('job_id') AND jobs.org_id = sqlc.arg('org_id')
+  AND applications.deleted_at IS NULL
+  AND applications.status <> 'saved'
+  AND (sqlc.narg('stage')::text IS NULL OR COALESCE(applicant_reviews.stage, first_stage.stage) = sqlc.narg('stage')::text)
+ORDER BY applications.created_at, applications.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: GetJobApplicant :one
+SELECT applications.id AS application_id, applications.created_at AS applied_at,
+       users.id AS candidate_id, users.email AS candidate_email,
+       COALESCE(applicant_reviews.stage, first_stage.stage)::text AS stage,
+       applicant_reviews.stage_changed_at,
+       (SELECT count(*) FROM applicant_notes
+        WHERE applicant_notes.application_id = applications.id AND applicant_notes.org_id = jobs.org_id)::bigint AS note_count
+FROM applications
+JOIN jobs ON jobs.id = applications.job_id
+JOIN users ON users.id = applications.user_id
+LEFT JOIN applicant_reviews ON applicant_reviews.application_id = applications.id AND applicant_reviews.org_id = jobs.org_id
+LEFT JOIN LATERAL (
+  SELECT stage FROM org_pipeline_stages
+  WHERE org_pipeline_stages.org_id = jobs.org_id
+  ORDER BY position, stage
+  LIMIT 1
+) AS first_stage ON true
+WHERE applications.id = sqlc.arg('application_id')
+  AND jobs.id = sqlc.arg('job_id') AND jobs.org_id = sqlc.arg('org_id')
+  AND applications.deleted_at IS NULL
+  AND applications.status <> 'saved';
+
+-- name: UpsertApplicantReview :one
+INSERT INTO applicant_reviews (application_id, org_id, stage, stage_changed_by)
+VALUES (sqlc.arg('application_id'), sqlc.arg('org_id'), sqlc.arg('stage'), sqlc.arg('changed_by'))
+ON CONFLICT (application_id) DO UPDATE
+SET org_id = EXCLUDED.org_id,
+    stage = EXCLUDED.stage,
+    stage_changed_at = now(),
+    stage_changed_by = EXCLUDED.stage_changed_by
+RETURNING *;
+
+-- name: CreateApplicantNote :one
+INSERT INTO applicant_notes (application_id, org_id, author_id, body)
+VALUES (sqlc.arg('application_id'), sqlc.arg('org_id'), sqlc.arg('author_id'), sqlc.arg('body'))
+RETURNING *;
+
+-- name: ListApplicantNotes :many
+SELECT sqlc.embed(applicant_notes), users.email AS author_email
+FROM applicant_notes
+LEFT JOIN users ON users.id = applicant_notes.author_id
+WHERE applicant_notes.application_id = sqlc.arg('application_id') AND applicant_notes.org_id = sqlc.arg('org_id')
+ORDER BY applicant_notes.created_at, applicant_notes.id;
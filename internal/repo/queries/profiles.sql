@@ -0,0 +1,58 @@
+-- name: GetProfile :one
+SELECT * FROM candidate_profiles
+WHERE user_id = sqlc.arg('user_id');
+
+-- name: UpsertProfile :one
+INSERT INTO candidate_profiles (user_id, name, headline, location, summary, skills, share_with_orgs)
+VALUES (sqlc.arg('user_id'), sqlc.narg('name'), sqlc.narg('headline'), sqlc.narg('location'),
+        sqlc.narg('summary'), sqlc.arg('skills'), sqlc.arg('share_with_orgs'))
+ON CONFLICT (user_id) DO UPDATE
+SET name            = EXCLUDED.name,
+    headline        = EXCLUDED.headline,
+    location        = EXCLUDED.location,
+    summary         = EXCLUDED.summary,
+    skills          = EXCLUDED.skills,
+    share_with_orgs = EXCLUDED.share_with_orgs,
+    updated_at      = now()
+RETURNING *;
+
+-- name: ClearProfileEntries :exec
+-- PUT profili bütün olarak değiştirir; deneyim / eğitim / link satırları yeniden yazılır
+WITH e AS (
+  DELETE FROM profile_experiences WHERE user_id = sqlc.arg('user_id')
+), d AS (
+  DELETE FROM profile_educations WHERE user_id = sqlc.arg('user_id')
+)
+DELETE FROM profile_links WHERE user_id = sqlc.arg('user_id');
+
+-- name: CreateProfileExperience :exec
+INSERT INTO profile_experiences (user_id, position, company, title, location, start_date, end_date, summary)
+VALUES (sqlc.arg('user_id'), sqlc.arg('position'), sqlc.arg('company'), sqlc.arg('title'),
+        sqlc.narg('location'), sqlc.narg('start_date'), sqlc.narg('end_date'), sqlc.narg('summary'));
+
+-- name: ListProfileExperiences :many
+SELECT user_id, position, company, title, location, start_date, end_date, summary
+FROM profile_experiences
+WHERE user_id = sqlc.arg('user_id')
+ORDER BY position;
+
+-- name: CreateProfileEducation :exec
+INSERT INTO profile_educations (user_id, position, institution, degree, field, start_date, end_date)
+VALUES (sqlc.arg('user_id'), sqlc.arg('position'), sqlc.arg('institution'), sqlc.narg('degree'),
+        sqlc.narg('field'), sqlc.narg('start_date'), sqlc.narg('end_date'));
+
+-- name: ListProfileEducations :many
+SELECT user_id, position, institution, degree, field, start_date, end_date
+FROM profile_educations
+WHERE user_id = sqlc.arg('user_id')
+ORDER BY position;
+
+-- name: CreateProfileLink :exec
+INSERT INTO profile_links (user_id, position, label, url)
+VALUES (sqlc.arg('user_id'), sqlc.arg('position'), sqlc.arg('label'), sqlc.arg('url'));
+
+-- name: ListProfileLinks :many
+SELECT user_id, position, label, url
+FROM profile_links
+WHERE user_id = sqlc.arg('user_id')
+ORDER BY position;
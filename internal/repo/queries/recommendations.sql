@@ -0,0 +1,75 @@
+-- name: ListRecommendedJobs :many
+-- Kullanıcının geçmiş başvurularındaki tag ve şirketlerle örtüşen görünür ilanlar
+-- (job_visible_to: kendi ilanları + herkese açık org ilanları). Her başvurunun ağırlığı ulaştığı en
+-- ileri aşamanın funnel sırasıdır (saved=1, applied=2, ...); ulaşılan aşamalar
+-- StatsFunnel gibi mevcut status + event'lerden çıkarılır, exit_statuses sayılmaz.
+-- İlan skoru: eşleşen tag ağırlıkları + şirket ağırlığı. Silinmemiş başvurusu olan ilanlar hariç.
+WITH apps AS (
+  SELECT a.id, a.status, lower(j.company) AS company, j.tags
+  FROM applications a
+  JOIN jobs j ON j.id = a.job_id
+  WHERE a.user_id = sqlc.arg('user_id')
+    AND a.deleted_at IS NULL
+),
+visited AS (
+  SELECT a.id, a.status FROM apps a
+  UNION
+  SELECT e.application_id, COALESCE(e.payload_json->>'new_status', e.payload_json->>'status')
+  FROM events e
+  JOIN apps a ON a.id = e.application_id
+  WHERE e.type IN ('application.created', 'application.status.changed')
+),
+stage_weights AS (
+  SELECT status, (row_number() OVER (ORDER BY position))::float8 AS weight
+  FROM application_stages
+  WHERE status <> ALL(sqlc.arg('exit_statuses')::text[])
+),
+progress AS (
+  SELECT v.id, max(w.weight) AS weight
+  FROM visited v
+  JOIN stage_weights w ON w.status = v.status
+  GROUP BY v.id
+),
+tag_signals AS (
+  SELECT t.tag, sum(p.weight) AS weight, count(*) AS applications, max(p.weight) AS best
+  FROM apps a
+  JOIN progress p ON p.id = a.id
+  CROSS JOIN LATERAL (SELECT DISTINCT lower(x) AS tag FROM unnest(a.tags) AS x) t
+  GROUP BY t.tag
+),
+company_signals AS (
+  SELECT a.company, sum(p.weight) AS weight, count(*) AS applications, max(p.weight) AS best
+  FROM apps a
+  JOIN progress p ON p.id = a.id
+  GROUP BY a.company
+)
+SELECT sqlc.embed(jobs),
+       (COALESCE(tm.weight, 0) + COALESCE(cs.weight, 0))::float8 AS score,
+       COALESCE(tm.tags, '{}')::text[] AS matched_tags,
+       COALESCE(tm.weights, '{}')::float8[] AS tag_weights,
+       COALESCE(tm.applications, '{}')::bigint[] AS tag_applications,
+       COALESCE(tm.best_statuses, '{}')::text[] AS tag_best_statuses,
+       COALESCE(cs.weight, 0)::float8 AS company_weight,
+       COALESCE(cs.applications, 0)::bigint AS company_applications,
+       (SELECT w.status FROM stage_weights w WHERE w.weight = cs.best) AS company_best_status
+FROM jobs
+LEFT JOIN company_signals cs ON cs.company = lower(jobs.company)
+LEFT JOIN LATERAL (
+  SELECT sum(ts.weight) AS weight,
+         array_agg(ts.tag ORDER BY ts.weight DESC, ts.tag) AS tags,
+         array_agg(ts.weight ORDER BY ts.weight DESC, ts.tag) AS weights,
+         array_agg(ts.applications ORDER BY ts.weight DESC, ts.tag) AS applications,
+         array_agg(w.status ORDER BY ts.weight DESC, ts.tag) AS best_statuses
+  FROM tag_signals ts
+  JOIN stage_weights w ON w.weight = ts.best
+  WHERE ts.tag IN (SELECT lower(x) FROM unnest(jobs.tags) AS x)
+) tm ON true
+WHERE job_visible_to(jobs.owner_id, jobs.org_id, sqlc.arg('user_id'))
+  AND NOT EXISTS (
+        SELECT 1 FROM applications a
+        WHERE a.job_id = jobs.id
+          AND a.user_id = sqlc.arg('user_id')
+          AND a.deleted_at IS NULL)
+  AND (tm.weight IS NOT NULL OR cs.weight IS NOT NULL)
+ORDER BY score DESC, jobs.created_at DESC, jobs.id DESC
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,30 @@
+-- name: ClaimDueReminder :one
+-- zamanı gelmiş ve bu tarih için henüz hatırlatılmamış sıradaki başvuru; SKIP LOCKED ile
+-- birden fazla worker aynı satırı almaz (satır transaction sonuna kadar kilitli kalır)
+SELECT applications.id, applications.user_id, applications.job_id, applications.status,
+       applications.next_action_at::timestamptz AS next_action_at,
+       users.email, jobs.title, jobs.company
+FROM applications
+JOIN users ON users.id = applications.user_id
+JOIN jobs ON jobs.id = applications.job_id
+JOIN application_stages ON application_stages.status = applications.status
+WHERE applications.deleted_at IS NULL
+  AND applications.next_action_at <= now()
+  AND applications.reminder_sent_for IS DISTINCT FROM applications.next_action_at
+  AND NOT application_stages.is_terminal
+  AND NOT (applications.id = ANY(sqlc.arg('skip_ids')::bigint[]))
+ORDER BY applications.next_action_at
+LIMIT 1
+FOR UPDATE OF applications SKIP LOCKED;
+
+-- name: MarkReminderSent :exec
+-- gönderimden önce commit edilir: e-posta kilit tutulmadan gönderilir
+UPDATE applications
+SET reminder_sent_for = sqlc.arg('next_action_at')
+WHERE id = sqlc.arg('id');
+
+-- name: UnmarkReminderSent :exec
+-- gönderim başarısızsa işaret geri alınır (bu arada next_action_at değiştiyse dokunulmaz)
+UPDATE applications
+SET reminder_sent_for = NULL
+WHERE id = sqlc.arg('id') AND reminder_sent_for = sqlc.arg('next_action_at');
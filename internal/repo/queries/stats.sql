@@ -0,0 +1,109 @@
+-- name: StatsFunnel :many
+-- Her aşama için: o aşamaya (veya funnel'da daha ilerisine) ulaşmış başvuru sayısı ve
+-- şu an o aşamada olanlar. Ulaşılan aşamalar mevcut status + created/status.changed
+-- event'lerinden çıkarılır; exit_statuses (rejected, withdrawn, ghosted) funnel ilerlemesi sayılmaz.
+-- Tarih aralığı başvurunun created_at'ine uygulanır.
+WITH apps AS (
+  SELECT id, status
+  FROM applications
+  WHERE user_id = sqlc.arg('user_id')
+    AND deleted_at IS NULL
+    AND (sqlc.narg('from')::timestamptz IS NULL OR created_at >= sqlc.narg('from'))
+    AND (sqlc.narg('to')::timestamptz IS NULL OR created_at < sqlc.narg('to'))
+),
+visited AS (
+  SELECT a.id, a.status FROM apps a
+  UNION
+  SELECT e.application_id, COALESCE(e.payload_json->>'new_status', e.payload_json->>'status')
+  FROM events e
+  JOIN apps a ON a.id = e.application_id
+  WHERE e.type IN ('application.created', 'application.status.changed')
+),
+reached AS (
+  SELECT v.id, max(s.position) AS max_position
+  FROM visited v
+  JOIN application_stages s ON s.status = v.status
+  WHERE v.status <> ALL(sqlc.arg('exit_statuses')::text[])
+  GROUP BY v.id
+)
+SELECT s.status,
+       s.position,
+       (s.status <> ALL(sqlc.arg('exit_statuses')::text[]))::boolean AS in_funnel,
+       (SELECT count(*) FROM reached r WHERE r.max_position >= s.position)::bigint AS reached,
+       (SELECT count(*) FROM apps a WHERE a.status = s.status)::bigint AS current
+FROM application_stages s
+ORDER BY s.position;
+
+-- name: StatsResponseTimes :many
+-- applied'a geçişten ilk firma yanıtına (status'un no_response_statuses dışına çıkması)
+-- kadar geçen gün; genel, şirket ve tag kırılımında medyan. Eski başvurularda
+-- application.created event'i yoksa applied zamanı created_at kabul edilir.
+WITH apps AS (
+  SELECT a.id, a.created_at, j.company, j.tags
+  FROM applications a
+  JOIN jobs j ON j.id = a.job_id
+  WHERE a.user_id = sqlc.arg('user_id')
+    AND a.deleted_at IS NULL
+    AND (sqlc.narg('from')::timestamptz IS NULL OR a.created_at >= sqlc.narg('from'))
+    AND (sqlc.narg('to')::timestamptz IS NULL OR a.created_at < sqlc.narg('to'))
+),
+applied AS (
+  SELECT a.*,
+         COALESCE(
+           (SELECT min(e.created_at) FROM events e
+            WHERE e.application_id = a.id
+              AND ((e.type = 'application.created' AND e.payload_json->>'status' = 'applied')
+                OR (e.type = 'application.status.changed' AND e.payload_json->>'new_status' = 'applied'))),
+           CASE WHEN NOT EXISTS (SELECT 1 FROM events e
+                                 WHERE e.application_id = a.id AND e.type = 'application.created')
+                THEN a.created_at END
+         ) AS applied_at
+  FROM apps a
+),
+timed AS (
+  SELECT p.id, p.company, p.tags,
+         EXTRACT(EPOCH FROM (
+           (SELECT min(e.created_at) FROM events e
+            WHERE e.application_id = p.id
+              AND e.type = 'application.status.changed'
+              AND e.created_at >= p.applied_at
+              AND e.payload_json->>'new_status' <> ALL(sqlc.arg('no_response_statuses')::text[]))
+           - p.applied_at)) / 86400.0 AS days
+  FROM applied p
+  WHERE p.applied_at IS NOT NULL
+),
+grouped AS (
+  SELECT 'overall'::text AS dimension, NULL::text AS key, days FROM timed
+  UNION ALL
+  SELECT 'company', company, days FROM timed
+  UNION ALL
+  SELECT 'tag', tag, days FROM timed, unnest(tags) AS tag
+)
+SELECT dimension,
+       key,
+       count(*)::bigint AS applied,
+       count(days)::bigint AS responded,
+       (percentile_cont(0.5) WITHIN GROUP (ORDER BY days))::float8 AS median_days
+FROM grouped
+GROUP BY dimension, key
+ORDER BY dimension, applied DESC, key;
+
+-- name: StatsWeeklyActivity :many
+-- [from, to) aralığında hafta (pazartesi, UTC) bazında event sayıları; boş haftalar 0 döner
+SELECT w.week::timestamptz AS week,
+       count(e.id) FILTER (WHERE e.type = 'application.created')::bigint AS applications,
+       count(e.id) FILTER (WHERE e.type = 'application.status.changed')::bigint AS status_changes,
+       count(e.id) FILTER (WHERE e.type = 'application.status.changed'
+                             AND e.payload_json->>'new_status' <> ALL(sqlc.arg('no_response_statuses')::text[]))::bigint AS responses,
+       count(e.id) FILTER (WHERE e.type = 'application.interview.scheduled')::bigint AS interviews,
+       count(e.id) FILTER (WHERE e.type = 'application.status.changed'
+                             AND e.payload_json->>'new_status' = 'offer')::bigint AS offers
+FROM generate_series(date_trunc('week', sqlc.arg('from')::timestamptz AT TIME ZONE 'UTC') AT TIME ZONE 'UTC',
+                     sqlc.arg('to')::timestamptz - interval '1 microsecond',
+                     interval '1 week') AS w(week)
+LEFT JOIN events e
+  ON e.user_id = sqlc.arg('user_id')
+ AND e.created_at >= w.week AND e.created_at < w.week + interval '1 week'
+ AND e.created_at >= sqlc.arg('from') AND e.created_at < sqlc.arg('to')
+GROUP BY w.week
+ORDER BY w.week;
@@ -0,0 +1,18 @@
+-- name: GetUserSettings :one
+-- satır yoksa varsayılanlar (tablo default'larıyla aynı)
+SELECT sqlc.arg('user_id')::bigint AS user_id,
+       COALESCE(s.ghosting_mode, 'flag')::text AS ghosting_mode,
+       COALESCE(s.ghosting_days, 30)::int AS ghosting_days,
+       s.ghost_digest_sent_at,
+       COALESCE(s.updated_at, now())::timestamptz AS updated_at
+FROM (SELECT 1) AS one
+LEFT JOIN user_settings s ON s.user_id = sqlc.arg('user_id');
+
+-- name: UpsertGhostingSettings :one
+INSERT INTO user_settings (user_id, ghosting_mode, ghosting_days)
+VALUES (sqlc.arg('user_id'), sqlc.arg('ghosting_mode'), sqlc.arg('ghosting_days'))
+ON CONFLICT (user_id) DO UPDATE
+SET ghosting_mode = EXCLUDED.ghosting_mode,
+    ghosting_days = EXCLUDED.ghosting_days,
+    updated_at    = now()
+RETURNING *;
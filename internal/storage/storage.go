@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var ErrNotFound = errors.New("storage: object not found")
+
+// Storage: dosya içerikleri için blob deposu. Key'ler "/" ile ayrılmış göreli yollardır.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Presigner: backend kendi imzalı indirme linkini üretebiliyorsa (S3) API dosyayı
+// proxy'lemek yerine o linke yönlendirir.
+type Presigner interface {
+	PresignGet(key string, ttl time.Duration, filename, contentType string) (string, error)
+}
@@ -0,0 +1,271 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	"github.com/Ali0NAL/talentpass/internal/mail"
+	"github.com/Ali0NAL/talentpass/internal/rank"
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+const (
+	// EventGhostDetected: başvuru eşikten uzun süre hareketsiz kaldığında yazılır
+	EventGhostDetected = "application.ghost.detected"
+
+	eventStatusChanged = "application.status.changed"
+	statusGhosted      = "ghosted"
+	ghostDigestPeriod  = 7 * 24 * time.Hour
+)
+
+// ghostingSystemEvents: son aktivite hesabında sayılmayan, worker'ların yazdığı event'ler
+var ghostingSystemEvents = []string{EventGhostDetected, EventReminderSent}
+
+// GhostingWorker: kullanıcının ghosting_days eşiğinden uzun süredir hareketsiz
+// başvurularını tespit eder. ghosting_mode=transition ise başvuru ghosted
+// aşamasına taşınır, flag ise yalnızca işaretlenir; iki durumda da event yazılır.
+// Tespitler kullanıcı başına haftalık bir özet e-postasında toplanır.
+//
+// ReminderWorker ile aynı model: her başvuru / özet kendi transaction'ında
+// SKIP LOCKED ile işlenir, birden fazla instance güvenle çalışır.
+type GhostingWorker struct {
+	pool     *pgxpool.Pool
+	q        *repo.Queries
+	mail     mail.Sender
+	interval time.Duration
+}
+
+func NewGhostingWorker(pool *pgxpool.Pool, sender mail.Sender, interval time.Duration) *GhostingWorker {
+	return &GhostingWorker{pool: pool, q: repo.New(pool), mail: sender, interval: interval}
+}
+
+// Run: ctx iptal edilene kadar her interval'de bir tarama + özet turu yapar.
+func (w *GhostingWorker) Run(ctx context.Context) {
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		if n, err := w.DetectOnce(ctx); err != nil {
+			log.Error().Err(err).Int("detected", n).Msg("ghosting scan failed")
+		} else if n > 0 {
+			log.Info().Int("detected", n).Msg("ghosted applications detected")
+		}
+		if n, err := w.DigestOnce(ctx); err != nil {
+			log.Error().Err(err).Int("sent", n).Msg("ghosting digest failed")
+		} else if n > 0 {
+			log.Info().Int("sent", n).Msg("ghosting digests sent")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// DetectOnce: eşiği geçmiş tüm başvuruları işler, tespit sayısını döner.
+func (w *GhostingWorker) DetectOnce(ctx context.Context) (int, error) {
+	detected := 0
+	skip := []int64{} // nil dizi NULL olur ve sorgu hiçbir satır döndürmez
+	for ctx.Err() == nil {
+		id, err := w.detectNext(ctx, skip)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return detected, nil
+		}
+		if err != nil {
+			if id == 0 {
+				return detected, err
+			}
+			log.Warn().Err(err).Int64("application_id", id).Msg("ghosting not applied")
+			skip = append(skip, id)
+			continue
+		}
+		detected++
+	}
+	return detected, ctx.Err()
+}
+
+// detectNext: sıradaki hareketsiz başvuruyu işaretler / taşır. Hata başvuruya özgüyse id ile döner.
+func (w *GhostingWorker) detectNext(ctx context.Context, skip []int64) (int64, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := w.q.WithTx(tx)
+
+	app, err := qtx.ClaimStaleApplication(ctx, repo.ClaimStaleApplicationParams{
+		SystemEventTypes: ghostingSystemEvents,
+		SkipIds:          skip,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if app.GhostingMode == "transition" {
+		if err := w.moveToGhosted(ctx, qtx, app); err != nil {
+			return app.ID, err
+		}
+	}
+	if err := qtx.MarkGhostDetected(ctx, app.ID); err != nil {
+		return app.ID, err
+	}
+	if err := createEvent(ctx, qtx, app.UserID, app.ID, EventGhostDetected, map[string]any{
+		"application_id":   app.ID,
+		"mode":             app.GhostingMode,
+		"inactivity_days":  app.GhostingDays,
+		"last_activity_at": app.LastActivityAt,
+		"status":           app.Status,
+	}); err != nil {
+		return app.ID, err
+	}
+	return app.ID, tx.Commit(ctx)
+}
+
+// moveToGhosted: başvuruyu ghosted kolonunun en üstüne taşır ve status.changed yazar.
+// Geçiş kuralı ClaimStaleApplication'da zaten kontrol edildi.
+func (w *GhostingWorker) moveToGhosted(ctx context.Context, q *repo.Queries, app repo.ClaimStaleApplicationRow) error {
+	if err := q.LockBoardColumn(ctx, repo.LockBoardColumnParams{UserID: app.UserID, Status: statusGhosted}); err != nil {
+		return err
+	}
+	top, err := q.GetBoardTopRank(ctx, repo.GetBoardTopRankParams{UserID: app.UserID, Status: statusGhosted})
+	if err != nil {
+		return err
+	}
+	boardRank := rank.First()
+	if top != "" {
+		if boardRank, err = rank.Between("", top); err != nil {
+			return err
+		}
+	}
+	updated, err := q.UpdateApplicationStatus(ctx, repo.UpdateApplicationStatusParams{
+		Status:    statusGhosted,
+		BoardRank: boardRank,
+		ID:        app.ID,
+		UserID:    app.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	return createEvent(ctx, q, app.UserID, app.ID, eventStatusChanged, map[string]any{
+		"application_id": app.ID,
+		"user_id":        app.UserID,
+		"old_status":     app.Status,
+		"new_status":     updated.Status,
+		"updated_at":     updated.UpdatedAt,
+		"note":           fmt.Sprintf("otomatik: %d gündür hareket yok", app.GhostingDays),
+	})
+}
+
+// DigestOnce: özet zamanı gelmiş kullanıcılara haftalık ghosting özetini gönderir.
+func (w *GhostingWorker) DigestOnce(ctx context.Context) (int, error) {
+	sent := 0
+	skip := []int64{}
+	for ctx.Err() == nil {
+		uid, err := w.digestNext(ctx, skip)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sent, nil
+		}
+		if err != nil {
+			if uid == 0 {
+				return sent, err
+			}
+			log.Warn().Err(err).Int64("user_id", uid).Msg("ghosting digest not sent")
+			skip = append(skip, uid)
+			continue
+		}
+		sent++
+	}
+	return sent, ctx.Err()
+}
+
+// digestNext: sıradaki kullanıcının özetini gönderir. Hata kullanıcıya özgüyse id ile döner.
+func (w *GhostingWorker) digestNext(ctx context.Context, skip []int64) (int64, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := w.q.WithTx(tx)
+
+	u, err := qtx.ClaimGhostDigestUser(ctx, repo.ClaimGhostDigestUserParams{
+		DetectedType: EventGhostDetected,
+		SkipIds:      skip,
+	})
+	if err != nil {
+		return 0, err
+	}
+	since := time.Now().Add(-ghostDigestPeriod)
+	if u.GhostDigestSentAt != nil {
+		since = *u.GhostDigestSentAt
+	}
+	items, err := qtx.ListGhostDigestItems(ctx, repo.ListGhostDigestItemsParams{
+		UserID:       u.ID,
+		DetectedType: EventGhostDetected,
+		Since:        since,
+	})
+	if err != nil {
+		return u.ID, err
+	}
+
+	// özet zamanı gönderimden önce ilerletilip commit edilir: e-posta users satır kilidi
+	// tutulmadan gönderilir. Tespit edilen başvurular bu arada silinmiş olabilir; yine de
+	// özet zamanı ilerler.
+	if err := qtx.MarkGhostDigestSent(ctx, u.ID); err != nil {
+		return u.ID, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return u.ID, err
+	}
+	if len(items) == 0 {
+		return u.ID, nil
+	}
+	if err := w.mail.Send(ctx, ghostDigestMessage(u.Email, items)); err != nil {
+		if rerr := w.q.RestoreGhostDigestSentAt(ctx, repo.RestoreGhostDigestSentAtParams{
+			SentAt: u.GhostDigestSentAt,
+			UserID: u.ID,
+		}); rerr != nil {
+			log.Error().Err(rerr).Int64("user_id", u.ID).Msg("ghost digest restore failed")
+		}
+		return u.ID, fmt.Errorf("send mail: %w", err)
+	}
+	return u.ID, nil
+}
+
+func ghostDigestMessage(to string, items []repo.ListGhostDigestItemsRow) mail.Message {
+	var b strings.Builder
+	b.WriteString("Merhaba,\n\nŞu başvuruların uzun süredir hareketsiz olduğunu tespit ettik:\n\n")
+	for _, it := range items {
+		fmt.Fprintf(&b, "- %s - %s (#%d, durum: %s, tespit: %s)\n",
+			it.Company, it.Title, it.ApplicationID, it.Status, it.DetectedAt.UTC().Format("2006-01-02"))
+	}
+	b.WriteString("\nBir başvuruyu takipten çıkarmak için: PATCH /v1/applications/{id} {\"ghosting_opt_out\": true}\n" +
+		"Eşik ve mod ayarları: PUT /v1/settings/ghosting\n\n" +
+		"TalentPass")
+	return mail.Message{
+		To:      to,
+		Subject: fmt.Sprintf("Haftalık özet: %d hareketsiz başvuru", len(items)),
+		Body:    b.String(),
+	}
+}
+
+func createEvent(ctx context.Context, q *repo.Queries, uid, appID int64, typ string, payload map[string]any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = q.CreateEvent(ctx, repo.CreateEventParams{
+		UserID:        &uid,
+		ApplicationID: &appID,
+		Type:          typ,
+		PayloadJson:   b,
+	})
+	return err
+}
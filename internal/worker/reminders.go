@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	"github.com/Ali0NAL/talentpass/internal/mail"
+	"github.com/Ali0NAL/talentpass/internal/repo"
+)
+
+// EventReminderSent: hatırlatma e-postası gönderildiğinde yazılan event tipi
+const EventReminderSent = "application.reminder.sent"
+
+// ReminderWorker: next_action_at'i geçmiş başvurular için e-posta hatırlatması gönderir.
+//
+// Her hatırlatma kısa bir transaction'da sahiplenilir: satır SKIP LOCKED ile kilitlenir,
+// reminder_sent_for yazılıp commit edilir; e-posta kilit ve bağlantı tutulmadan sonra
+// gönderilir, ardından event yazılır. Böylece birden fazla instance aynı satırı işlemez ve
+// yanıt vermeyen bir SMTP sunucusu DB bağlantısı / satır kilidi bekletmez. Gönderim
+// başarısızsa işaret geri alınır; sahiplenme ile gönderim arasında çökme olursa hatırlatma
+// gitmez (at-most-once).
+type ReminderWorker struct {
+	pool     *pgxpool.Pool
+	q        *repo.Queries
+	mail     mail.Sender
+	interval time.Duration
+}
+
+func NewReminderWorker(pool *pgxpool.Pool, sender mail.Sender, interval time.Duration) *ReminderWorker {
+	return &ReminderWorker{pool: pool, q: repo.New(pool), mail: sender, interval: interval}
+}
+
+// Run: ctx iptal edilene kadar her interval'de bir tarama yapar.
+func (w *ReminderWorker) Run(ctx context.Context) {
+	t := time.NewTicker(w.interval)
+	defer t.Stop()
+	for {
+		if n, err := w.RunOnce(ctx); err != nil {
+			log.Error().Err(err).Int("sent", n).Msg("reminder scan failed")
+		} else if n > 0 {
+			log.Info().Int("sent", n).Msg("reminders sent")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// RunOnce: zamanı gelmiş tüm hatırlatmaları işler, gönderilen sayısını döner.
+// Gönderimi başarısız olan başvurular bu turda atlanır, sonraki turda tekrar denenir.
+func (w *ReminderWorker) RunOnce(ctx context.Context) (int, error) {
+	sent := 0
+	skip := []int64{} // nil dizi NULL olur ve sorgu hiçbir satır döndürmez
+	for ctx.Err() == nil {
+		id, err := w.processNext(ctx, skip)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return sent, nil
+		}
+		if err != nil {
+			if id == 0 {
+				return sent, err
+			}
+			log.Warn().Err(err).Int64("application_id", id).Msg("reminder not sent")
+			skip = append(skip, id)
+			continue
+		}
+		sent++
+	}
+	return sent, ctx.Err()
+}
+
+// processNext: sıradaki hatırlatmayı gönderir. Hata başvuruya özgüyse id ile döner.
+func (w *ReminderWorker) processNext(ctx context.Context, skip []int64) (int64, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := w.q.WithTx(tx)
+
+	rem, err := qtx.ClaimDueReminder(ctx, skip)
+	if err != nil {
+		return 0, err
+	}
+	if err := qtx.MarkReminderSent(ctx, repo.MarkReminderSentParams{
+		NextActionAt: &rem.NextActionAt,
+		ID:           rem.ID,
+	}); err != nil {
+		return rem.ID, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return rem.ID, err
+	}
+
+	if err := w.mail.Send(ctx, reminderMessage(rem)); err != nil {
+		if uerr := w.q.UnmarkReminderSent(ctx, repo.UnmarkReminderSentParams{
+			ID:           rem.ID,
+			NextActionAt: &rem.NextActionAt,
+		}); uerr != nil {
+			log.Error().Err(uerr).Int64("application_id", rem.ID).Msg("reminder unmark failed")
+		}
+		return rem.ID, fmt.Errorf("send mail: %w", err)
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"application_id": rem.ID,
+		"job_id":         rem.JobID,
+		"next_action_at": rem.NextActionAt,
+		"email":          rem.Email,
+	})
+	if err != nil {
+		return rem.ID, err
+	}
+	_, err = w.q.CreateEvent(ctx, repo.CreateEventParams{
+		UserID:        &rem.UserID,
+		ApplicationID: &rem.ID,
+		Type:          EventReminderSent,
+		PayloadJson:   payload,
+	})
+	return rem.ID, err
+}
+
+func reminderMessage(rem repo.ClaimDueReminderRow) mail.Message {
+	return mail.Message{
+		To:      rem.Email,
+		Subject: fmt.Sprintf("Hatırlatma: %s - %s", rem.Company, rem.Title),
+		Body: fmt.Sprintf(
+			"Merhaba,\n\n%s şirketindeki \"%s\" başvurun (#%d, durum: %s) için planladığın aksiyonun zamanı geldi (%s).\n\n"+
+				"Ertelemek için: POST /v1/applications/%d/reminder:snooze\n"+
+				"Tamamlandı olarak işaretlemek için: POST /v1/applications/%d/reminder:complete\n\n"+
+				"TalentPass",
+			rem.Company, rem.Title, rem.ID, rem.Status, rem.NextActionAt.UTC().Format(time.RFC3339), rem.ID, rem.ID,
+		),
+	}
+}
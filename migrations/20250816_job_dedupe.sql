@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- ilanı oluşturan kullanıcı + normalize edilmiş URL
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS owner_id BIGINT REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS canonical_url TEXT;
+
+-- aynı kullanıcı aynı ilanı iki kez ekleyemesin
+CREATE UNIQUE INDEX IF NOT EXISTS uq_jobs_owner_canonical_url
+  ON jobs(owner_id, canonical_url)
+  WHERE canonical_url IS NOT NULL;
+
+-- company + title benzerlik araması için
+CREATE INDEX IF NOT EXISTS idx_jobs_company_title_trgm
+  ON jobs USING gin ((company || ' ' || title) gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_jobs_company_title_trgm;
+DROP INDEX IF EXISTS uq_jobs_owner_canonical_url;
+ALTER TABLE jobs DROP COLUMN IF EXISTS canonical_url;
+ALTER TABLE jobs DROP COLUMN IF EXISTS owner_id;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS job_revisions (
+  id         BIGSERIAL PRIMARY KEY,
+  job_id     BIGINT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+  rev        INT    NOT NULL,                -- ilan bazında 1'den artan
+  user_id    BIGINT REFERENCES users(id) ON DELETE SET NULL,
+  changes    JSONB  NOT NULL,                -- {"alan": {"before": .., "after": ..}}
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (job_id, rev)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS job_revisions;
@@ -0,0 +1,68 @@
+-- +goose Up
+-- Başvuru pipeline'ı: aşamalar ve izin verilen geçişler tabloda tutulur,
+-- kod değişmeden yeni aşama/geçiş eklenebilir.
+CREATE TABLE IF NOT EXISTS application_stages (
+  status      TEXT    PRIMARY KEY,
+  position    INT     NOT NULL,               -- board/funnel sırası
+  is_initial  BOOLEAN NOT NULL DEFAULT false, -- başvuru bu aşamayla oluşturulabilir
+  is_terminal BOOLEAN NOT NULL DEFAULT false  -- çıkış geçişi yok
+);
+
+INSERT INTO application_stages (status, position, is_initial, is_terminal) VALUES
+  ('saved',     10, true,  false),
+  ('applied',   20, true,  false),
+  ('screening', 30, false, false),
+  ('interview', 40, false, false),
+  ('offer',     50, false, false),
+  ('accepted',  60, false, true),
+  ('rejected',  70, false, true),
+  ('withdrawn', 80, false, true),
+  ('ghosted',   90, false, false)
+ON CONFLICT (status) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS application_transitions (
+  from_status TEXT NOT NULL REFERENCES application_stages(status) ON UPDATE CASCADE ON DELETE CASCADE,
+  to_status   TEXT NOT NULL REFERENCES application_stages(status) ON UPDATE CASCADE ON DELETE CASCADE,
+  PRIMARY KEY (from_status, to_status)
+);
+
+INSERT INTO application_transitions (from_status, to_status) VALUES
+  ('saved',     'applied'),
+  ('saved',     'withdrawn'),
+  ('applied',   'screening'),
+  ('applied',   'interview'),
+  ('applied',   'rejected'),
+  ('applied',   'withdrawn'),
+  ('applied',   'ghosted'),
+  ('screening', 'interview'),
+  ('screening', 'rejected'),
+  ('screening', 'withdrawn'),
+  ('screening', 'ghosted'),
+  ('interview', 'offer'),
+  ('interview', 'rejected'),
+  ('interview', 'withdrawn'),
+  ('interview', 'ghosted'),
+  ('offer',     'accepted'),
+  ('offer',     'rejected'),
+  ('offer',     'withdrawn'),
+  -- ghosted sonrası geri dönüş gelebilir
+  ('ghosted',   'screening'),
+  ('ghosted',   'interview'),
+  ('ghosted',   'rejected'),
+  ('ghosted',   'withdrawn')
+ON CONFLICT DO NOTHING;
+
+-- eski serbest metin değerleri yeni aşamalara taşı
+UPDATE applications SET status = 'rejected' WHERE status = 'denied';
+UPDATE applications SET status = 'applied'
+WHERE status NOT IN (SELECT status FROM application_stages);
+
+ALTER TABLE applications
+  ADD CONSTRAINT fk_applications_status
+  FOREIGN KEY (status) REFERENCES application_stages(status) ON UPDATE CASCADE;
+
+-- +goose Down
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS fk_applications_status;
+UPDATE applications SET status = 'denied' WHERE status = 'rejected';
+DROP TABLE IF EXISTS application_transitions;
+DROP TABLE IF EXISTS application_stages;
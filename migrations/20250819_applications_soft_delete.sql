@@ -0,0 +1,6 @@
+-- +goose Up
+-- silinen başvurular event geçmişiyle birlikte saklanır
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE applications DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- aynı ilana birden fazla aktif başvuru olmasın; en eskisi kalır, diğerleri soft delete
+UPDATE applications a
+SET deleted_at = now(), updated_at = now()
+WHERE a.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM applications b
+    WHERE b.user_id = a.user_id AND b.job_id = a.job_id
+      AND b.deleted_at IS NULL AND b.id < a.id
+  );
+
+-- silinmiş başvurular aynı ilana yeniden başvurmayı engellemez
+CREATE UNIQUE INDEX IF NOT EXISTS uq_applications_user_job
+  ON applications(user_id, job_id)
+  WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS uq_applications_user_job;
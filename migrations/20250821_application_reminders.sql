@@ -0,0 +1,12 @@
+-- +goose Up
+-- reminder_sent_for: hatırlatması gönderilen next_action_at değeri. next_action_at
+-- değişince (snooze / PATCH) eşitlik bozulur ve yeni tarih için tekrar hatırlatılır.
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS reminder_sent_for TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_applications_next_action_at
+  ON applications(next_action_at)
+  WHERE next_action_at IS NOT NULL AND deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_applications_next_action_at;
+ALTER TABLE applications DROP COLUMN IF EXISTS reminder_sent_for;
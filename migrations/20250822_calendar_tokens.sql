@@ -0,0 +1,12 @@
+-- +goose Up
+-- iCalendar abonelik linki: kullanıcı başına tek token, sadece hash saklanır.
+-- Yeniden üretmek eski linki geçersiz kılar.
+CREATE TABLE IF NOT EXISTS calendar_tokens (
+  user_id     BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  token_hash  TEXT NOT NULL,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_calendar_tokens_hash ON calendar_tokens(token_hash);
+
+-- +goose Down
+DROP TABLE IF EXISTS calendar_tokens;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- başvuru başına mülakat turları; saatler timestamptz, gösterim için IANA timezone ayrıca saklanır
+CREATE TABLE IF NOT EXISTS interviews (
+  id              BIGSERIAL PRIMARY KEY,
+  application_id  BIGINT NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  round_name      TEXT NOT NULL,
+  type            TEXT NOT NULL CHECK (type IN ('phone', 'technical', 'onsite')),
+  starts_at       TIMESTAMPTZ NOT NULL,
+  ends_at         TIMESTAMPTZ NOT NULL,
+  timezone        TEXT NOT NULL DEFAULT 'UTC',
+  location        TEXT,
+  meeting_url     TEXT,
+  interviewers    TEXT[] NOT NULL DEFAULT '{}',
+  outcome         TEXT NOT NULL DEFAULT 'pending' CHECK (outcome IN ('pending', 'passed', 'failed', 'cancelled')),
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CHECK (ends_at > starts_at)
+);
+CREATE INDEX IF NOT EXISTS idx_interviews_application_id ON interviews(application_id, starts_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS interviews;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- başvuru notları: tek notes kolonu yerine yazarlı, düzenlenebilir, sabitlenebilir notlar
+CREATE TABLE IF NOT EXISTS application_notes (
+  id              BIGSERIAL PRIMARY KEY,
+  application_id  BIGINT NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  author_id       BIGINT REFERENCES users(id) ON DELETE SET NULL,
+  body            TEXT NOT NULL, -- markdown
+  pinned          BOOLEAN NOT NULL DEFAULT false,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  edited_at       TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_application_notes_application_id ON application_notes(application_id, created_at);
+-- full-text arama; dil karışık (TR/EN) olduğu için 'simple' sözlük
+CREATE INDEX IF NOT EXISTS idx_application_notes_fts ON application_notes USING GIN (to_tsvector('simple', body));
+
+-- mevcut notes kolonu ilk not olarak taşınır
+INSERT INTO application_notes (application_id, author_id, body, created_at)
+SELECT id, user_id, notes, updated_at
+FROM applications
+WHERE notes IS NOT NULL AND btrim(notes) <> '';
+
+ALTER TABLE applications DROP COLUMN IF EXISTS notes;
+
+-- +goose Down
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS notes TEXT;
+UPDATE applications a
+SET notes = n.body
+FROM (
+  SELECT application_id, string_agg(body, E'\n\n' ORDER BY created_at) AS body
+  FROM application_notes
+  GROUP BY application_id
+) n
+WHERE n.application_id = a.id;
+DROP TABLE IF EXISTS application_notes;
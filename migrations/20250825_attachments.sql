@@ -0,0 +1,28 @@
+-- +goose Up
+-- yüklenen dosyalar: içerik SHA-256 ile adreslenir, kullanıcı başına aynı dosya bir kez saklanır
+CREATE TABLE IF NOT EXISTS attachments (
+  id            BIGSERIAL PRIMARY KEY,
+  user_id       BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  sha256        TEXT NOT NULL,
+  size_bytes    BIGINT NOT NULL,
+  content_type  TEXT NOT NULL,
+  storage_key   TEXT NOT NULL,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (user_id, sha256)
+);
+
+-- hangi başvuruda hangi CV / ön yazı versiyonu kullanıldı
+CREATE TABLE IF NOT EXISTS application_attachments (
+  id              BIGSERIAL PRIMARY KEY,
+  application_id  BIGINT NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  attachment_id   BIGINT NOT NULL REFERENCES attachments(id) ON DELETE CASCADE,
+  kind            TEXT NOT NULL DEFAULT 'other' CHECK (kind IN ('resume', 'cover_letter', 'other')),
+  filename        TEXT NOT NULL,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (application_id, attachment_id)
+);
+CREATE INDEX IF NOT EXISTS idx_application_attachments_application_id ON application_attachments(application_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS application_attachments;
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,43 @@
+-- +goose Up
+-- kullanıcıya ait kişiler (recruiter, hiring manager, referans); ilan ve başvurulara n-n bağlanır
+CREATE TABLE IF NOT EXISTS contacts (
+  id            BIGSERIAL PRIMARY KEY,
+  user_id       BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name          TEXT NOT NULL,
+  email         TEXT,
+  phone         TEXT,
+  linkedin_url  TEXT,
+  company       TEXT,
+  role          TEXT,
+  notes         TEXT,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_contacts_user_id ON contacts(user_id, name);
+
+CREATE TABLE IF NOT EXISTS contact_jobs (
+  contact_id  BIGINT NOT NULL REFERENCES contacts(id) ON DELETE CASCADE,
+  job_id      BIGINT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (contact_id, job_id)
+);
+CREATE INDEX IF NOT EXISTS idx_contact_jobs_job_id ON contact_jobs(job_id);
+
+CREATE TABLE IF NOT EXISTS contact_applications (
+  contact_id      BIGINT NOT NULL REFERENCES contacts(id) ON DELETE CASCADE,
+  application_id  BIGINT NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (contact_id, application_id)
+);
+CREATE INDEX IF NOT EXISTS idx_contact_applications_application_id ON contact_applications(application_id);
+
+-- etkileşim kaydı events tablosunda tutulur
+ALTER TABLE events ADD COLUMN IF NOT EXISTS contact_id BIGINT;
+CREATE INDEX IF NOT EXISTS idx_events_contact_id ON events(contact_id) WHERE contact_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_events_contact_id;
+ALTER TABLE events DROP COLUMN IF EXISTS contact_id;
+DROP TABLE IF EXISTS contact_applications;
+DROP TABLE IF EXISTS contact_jobs;
+DROP TABLE IF EXISTS contacts;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- kanban kolonu (status) içi sıra: fractional index (internal/rank), bayt sırasıyla karşılaştırılır
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS board_rank TEXT COLLATE "C" NOT NULL DEFAULT '';
+
+-- mevcut başvurular: her kolonda en son güncellenen üstte. Sabit uzunluklu anahtarlar;
+-- 'i' soneki anahtarın '0' ile bitmemesi için.
+UPDATE applications a
+SET board_rank = r.board_rank
+FROM (
+  SELECT id,
+         'a' || lpad(row_number() OVER (PARTITION BY user_id, status ORDER BY updated_at DESC, id DESC)::text, 6, '0') || 'i' AS board_rank
+  FROM applications
+) r
+WHERE r.id = a.id;
+
+ALTER TABLE applications ALTER COLUMN board_rank DROP DEFAULT;
+ALTER TABLE applications ADD CONSTRAINT applications_board_rank_check
+  CHECK (board_rank ~ '^[0-9a-z]*[1-9a-z]$');
+
+CREATE INDEX IF NOT EXISTS idx_applications_board
+  ON applications(user_id, status, board_rank, id) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_applications_board;
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_board_rank_check;
+ALTER TABLE applications DROP COLUMN IF EXISTS board_rank;
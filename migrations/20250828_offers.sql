@@ -0,0 +1,25 @@
+-- +goose Up
+-- başvuru teklifleri; tutarlar teklifin para biriminde tam sayı (kuruş yok).
+-- Bir başvuruda revize teklifler için birden çok kayıt olabilir.
+CREATE TABLE IF NOT EXISTS offers (
+  id                     BIGSERIAL PRIMARY KEY,
+  application_id         BIGINT NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  currency               TEXT NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+  base_salary            BIGINT NOT NULL CHECK (base_salary >= 0),
+  salary_period          TEXT NOT NULL DEFAULT 'year' CHECK (salary_period IN ('year', 'month', 'hour')),
+  bonus                  BIGINT CHECK (bonus >= 0),           -- yıllık hedef bonus
+  signing_bonus          BIGINT CHECK (signing_bonus >= 0),   -- tek seferlik
+  equity_value           BIGINT CHECK (equity_value >= 0),    -- toplam hisse/opsiyon değeri
+  equity_vesting_months  INT CHECK (equity_vesting_months > 0),
+  equity_cliff_months    INT CHECK (equity_cliff_months >= 0),
+  benefits               TEXT[] NOT NULL DEFAULT '{}',
+  start_date             DATE,
+  respond_by             TIMESTAMPTZ,
+  notes                  TEXT,
+  created_at             TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at             TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_offers_application_id ON offers(application_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS offers;
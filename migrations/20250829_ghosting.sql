@@ -0,0 +1,26 @@
+-- +goose Up
+-- kullanıcı ayarları; satırı olmayan kullanıcı varsayılanları kullanır (sorgularda COALESCE)
+CREATE TABLE IF NOT EXISTS user_settings (
+  user_id               BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  -- off: kapalı, flag: sadece işaretle, transition: status -> ghosted
+  -- varsayılan flag: başvuruyu ghosted'a taşımak açıkça seçilmelidir; sorgulardaki
+  -- COALESCE'ler de 'flag' (user_settings.sql, ghosting.sql). Digest satır açarsa
+  -- (MarkGhostDigestSent) o satır da flag ile başlar.
+  ghosting_mode         TEXT NOT NULL DEFAULT 'flag' CHECK (ghosting_mode IN ('off', 'flag', 'transition')),
+  ghosting_days         INT  NOT NULL DEFAULT 30 CHECK (ghosting_days BETWEEN 1 AND 365),
+  ghost_digest_sent_at  TIMESTAMPTZ,
+  updated_at            TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- ghosting_opt_out: bu başvuru için tespit yapılmaz
+-- ghost_detected_at: son tespit; sonrasında aktivite olmadıkça tekrar tespit edilmez
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS ghosting_opt_out  BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS ghost_detected_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_events_type_created_at ON events(type, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_events_type_created_at;
+ALTER TABLE applications DROP COLUMN IF EXISTS ghost_detected_at;
+ALTER TABLE applications DROP COLUMN IF EXISTS ghosting_opt_out;
+DROP TABLE IF EXISTS user_settings;
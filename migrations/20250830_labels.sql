@@ -0,0 +1,47 @@
+-- +goose Up
+-- kullanıcıya ait etiketler; başvuru ve ilanlara n-n bağlanır. Ad kullanıcı içinde
+-- büyük/küçük harf duyarsız tekildir (yeniden adlandırmada çakışma -> merge).
+CREATE TABLE IF NOT EXISTS labels (
+  id          BIGSERIAL PRIMARY KEY,
+  user_id     BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name        TEXT NOT NULL CHECK (length(name) BETWEEN 1 AND 50),
+  color       TEXT NOT NULL DEFAULT '#9ca3af' CHECK (color ~ '^#[0-9a-f]{6}$'),
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS ux_labels_user_name ON labels(user_id, lower(name));
+
+CREATE TABLE IF NOT EXISTS label_applications (
+  label_id        BIGINT NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+  application_id  BIGINT NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (label_id, application_id)
+);
+CREATE INDEX IF NOT EXISTS idx_label_applications_application_id ON label_applications(application_id);
+
+CREATE TABLE IF NOT EXISTS label_jobs (
+  label_id    BIGINT NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+  job_id      BIGINT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (label_id, job_id)
+);
+CREATE INDEX IF NOT EXISTS idx_label_jobs_job_id ON label_jobs(job_id);
+
+-- mevcut ilan tag'leri ilan sahibinin etiketlerine dönüştürülür (jobs.tags import/export için kalır)
+INSERT INTO labels (user_id, name)
+SELECT DISTINCT ON (jobs.owner_id, lower(btrim(t.tag))) jobs.owner_id, btrim(t.tag)
+FROM jobs, unnest(jobs.tags) AS t(tag)
+WHERE jobs.owner_id IS NOT NULL AND length(btrim(t.tag)) BETWEEN 1 AND 50
+ORDER BY jobs.owner_id, lower(btrim(t.tag)), btrim(t.tag)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO label_jobs (label_id, job_id)
+SELECT DISTINCT labels.id, jobs.id
+FROM jobs, unnest(jobs.tags) AS t(tag), labels
+WHERE labels.user_id = jobs.owner_id AND lower(labels.name) = lower(btrim(t.tag))
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS label_jobs;
+DROP TABLE IF EXISTS label_applications;
+DROP TABLE IF EXISTS labels;
@@ -0,0 +1,49 @@
+-- +goose Up
+-- handler admin rolünü zaten kabul ediyordu; CHECK'e eklenir
+ALTER TABLE org_members DROP CONSTRAINT IF EXISTS org_members_role_check;
+ALTER TABLE org_members ADD CONSTRAINT org_members_role_check CHECK (role IN ('owner', 'admin', 'member'));
+
+-- org'un kendi aday pipeline'ı; adayın kendi (özel) başvuru status'undan bağımsız
+CREATE TABLE IF NOT EXISTS org_pipeline_stages (
+  org_id    BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  stage     TEXT   NOT NULL CHECK (stage ~ '^[a-z0-9_]{1,32}$'),
+  position  INT    NOT NULL,
+  PRIMARY KEY (org_id, stage)
+);
+
+INSERT INTO org_pipeline_stages (org_id, stage, position)
+SELECT organizations.id, t.stage, t.position * 10
+FROM organizations,
+     unnest(ARRAY['new', 'screening', 'interview', 'offer', 'hired', 'rejected']) WITH ORDINALITY AS t(stage, position)
+ON CONFLICT DO NOTHING;
+
+-- başvurunun org tarafındaki aşaması; satır yoksa aday pipeline'ın ilk aşamasındadır.
+-- Kullanımdaki aşama pipeline'dan silinemez (FK).
+CREATE TABLE IF NOT EXISTS applicant_reviews (
+  application_id    BIGINT PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+  org_id            BIGINT NOT NULL,
+  stage             TEXT   NOT NULL,
+  stage_changed_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+  stage_changed_by  BIGINT REFERENCES users(id) ON DELETE SET NULL,
+  FOREIGN KEY (org_id, stage) REFERENCES org_pipeline_stages(org_id, stage)
+);
+CREATE INDEX IF NOT EXISTS idx_applicant_reviews_org_stage ON applicant_reviews(org_id, stage);
+
+-- sadece org üyelerinin gördüğü notlar; aday hiçbir endpoint'ten göremez
+CREATE TABLE IF NOT EXISTS applicant_notes (
+  id              BIGSERIAL PRIMARY KEY,
+  application_id  BIGINT NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+  org_id          BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+  author_id       BIGINT REFERENCES users(id) ON DELETE SET NULL,
+  body            TEXT   NOT NULL,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_applicant_notes_application ON applicant_notes(application_id, org_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS applicant_notes;
+DROP TABLE IF EXISTS applicant_reviews;
+DROP TABLE IF EXISTS org_pipeline_stages;
+UPDATE org_members SET role = 'member' WHERE role = 'admin';
+ALTER TABLE org_members DROP CONSTRAINT IF EXISTS org_members_role_check;
+ALTER TABLE org_members ADD CONSTRAINT org_members_role_check CHECK (role IN ('owner', 'member'));
@@ -0,0 +1,53 @@
+-- +goose Up
+-- aday profili; kullanıcı başına tek satır, PUT ile bütün olarak değiştirilir
+CREATE TABLE IF NOT EXISTS candidate_profiles (
+  user_id          BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  name             TEXT,
+  headline         TEXT,
+  location         TEXT,
+  summary          TEXT,
+  skills           TEXT[] NOT NULL DEFAULT '{}',
+  -- true: başvurduğu org ilanlarının owner/admin'leri profili görebilir; paylaşım
+  -- açıkça istenmelidir (varsayılan kapalı)
+  share_with_orgs  BOOLEAN NOT NULL DEFAULT false,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- tarihler JSON Resume gibi kısmi olabilir: YYYY, YYYY-MM veya YYYY-MM-DD
+CREATE TABLE IF NOT EXISTS profile_experiences (
+  user_id     BIGINT NOT NULL REFERENCES candidate_profiles(user_id) ON DELETE CASCADE,
+  position    INT NOT NULL,
+  company     TEXT NOT NULL,
+  title       TEXT NOT NULL,
+  location    TEXT,
+  start_date  TEXT CHECK (start_date ~ '^\d{4}(-\d{2}(-\d{2})?)?$'),
+  end_date    TEXT CHECK (end_date ~ '^\d{4}(-\d{2}(-\d{2})?)?$'),
+  summary     TEXT,
+  PRIMARY KEY (user_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS profile_educations (
+  user_id      BIGINT NOT NULL REFERENCES candidate_profiles(user_id) ON DELETE CASCADE,
+  position     INT NOT NULL,
+  institution  TEXT NOT NULL,
+  degree       TEXT,
+  field        TEXT,
+  start_date   TEXT CHECK (start_date ~ '^\d{4}(-\d{2}(-\d{2})?)?$'),
+  end_date     TEXT CHECK (end_date ~ '^\d{4}(-\d{2}(-\d{2})?)?$'),
+  PRIMARY KEY (user_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS profile_links (
+  user_id   BIGINT NOT NULL REFERENCES candidate_profiles(user_id) ON DELETE CASCADE,
+  position  INT NOT NULL,
+  label     TEXT NOT NULL,
+  url       TEXT NOT NULL,
+  PRIMARY KEY (user_id, position)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS profile_links;
+DROP TABLE IF EXISTS profile_educations;
+DROP TABLE IF EXISTS profile_experiences;
+DROP TABLE IF EXISTS candidate_profiles;
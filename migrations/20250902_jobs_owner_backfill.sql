@@ -0,0 +1,96 @@
+-- +goose Up
+-- 20250816 öncesi eklenen ilanlarda owner_id / canonical_url boş kaldı: birleştirilemiyor,
+-- kopya tespitine ve export'a girmiyor, 20250830'da etiketleri de oluşmadı.
+-- Sahip: org ilanında org'un ilk owner'ı; kişisel ilanda sadece tek bir kullanıcının
+-- başvurusu varsa o kullanıcı. Birden fazla kullanıcının başvurduğu (paylaşılan) ya da hiç
+-- başvurusu olmayan kişisel ilanlar sahipsiz kalır: salt okunur (kimse düzenleyemez / silemez).
+CREATE TEMP TABLE backfilled_jobs (id BIGINT PRIMARY KEY);
+
+WITH u AS (
+  UPDATE jobs j
+  SET owner_id = o.user_id
+  FROM (
+    SELECT DISTINCT ON (org_id) org_id, user_id
+    FROM org_members
+    WHERE role = 'owner'
+    ORDER BY org_id, created_at, user_id
+  ) o
+  WHERE j.owner_id IS NULL AND j.org_id = o.org_id
+  RETURNING j.id
+)
+INSERT INTO backfilled_jobs SELECT id FROM u;
+
+WITH u AS (
+  UPDATE jobs j
+  SET owner_id = a.user_id
+  FROM (
+    SELECT job_id, min(user_id) AS user_id
+    FROM applications
+    GROUP BY job_id
+    HAVING count(DISTINCT user_id) = 1
+  ) a
+  WHERE j.owner_id IS NULL AND j.org_id IS NULL AND j.id = a.job_id
+  RETURNING j.id
+)
+INSERT INTO backfilled_jobs SELECT id FROM u;
+
+-- canonicalURL() (internal/http/canonical_url.go) ile aynı kurallar: şema/host küçük harf,
+-- kullanıcı bilgisi / fragment / utm_* atılır, query anahtara göre sıralanır, sondaki "/" silinir.
+-- Percent-encoding normalize edilmez; fark sadece kaçırılan bir kopya eşleşmesi demektir.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION tp_backfill_canonical_url(raw TEXT) RETURNS TEXT
+LANGUAGE sql IMMUTABLE AS $$
+  SELECT lower(m[1]) || '://' || lower(m[2]) || rtrim(m[3], '/') ||
+         COALESCE('?' || (
+           SELECT string_agg(CASE WHEN strpos(p, '=') = 0 THEN p || '=' ELSE p END, '&'
+                             ORDER BY split_part(p, '=', 1), ord)
+           FROM unnest(string_to_array(m[4], '&')) WITH ORDINALITY AS q(p, ord)
+           WHERE p <> '' AND lower(split_part(p, '=', 1)) NOT LIKE 'utm\_%'
+         ), '')
+  FROM (SELECT regexp_match(btrim(raw), '^([A-Za-z][A-Za-z0-9+.-]*)://(?:[^/?#@]*@)?([^/?#]+)([^?#]*)(?:\?([^#]*))?') AS m) s
+  WHERE m IS NOT NULL
+$$;
+-- +goose StatementEnd
+
+-- aynı sahipte aynı URL'ye inen eski ilanlardan sadece ilki canonical_url alır (unique index);
+-- diğerleri kopya olarak :merge ile birleştirilebilir
+UPDATE jobs j
+SET canonical_url = c.url
+FROM (
+  SELECT id, url, row_number() OVER (PARTITION BY owner_id, url ORDER BY id) AS n
+  FROM (
+    SELECT id, owner_id, tp_backfill_canonical_url(url) AS url
+    FROM jobs
+    WHERE url IS NOT NULL AND canonical_url IS NULL
+  ) x
+  WHERE url IS NOT NULL
+) c
+WHERE j.id = c.id
+  AND c.n = 1
+  AND NOT EXISTS (
+    SELECT 1 FROM jobs o
+    WHERE o.owner_id = j.owner_id AND o.canonical_url = c.url
+  );
+
+DROP FUNCTION tp_backfill_canonical_url(TEXT);
+
+-- 20250830 ile aynı: yeni sahiplenilen ilanların tag'leri sahibin etiketlerine dönüşür
+INSERT INTO labels (user_id, name)
+SELECT DISTINCT ON (jobs.owner_id, lower(btrim(t.tag))) jobs.owner_id, btrim(t.tag)
+FROM jobs
+JOIN backfilled_jobs b ON b.id = jobs.id, unnest(jobs.tags) AS t(tag)
+WHERE length(btrim(t.tag)) BETWEEN 1 AND 50
+ORDER BY jobs.owner_id, lower(btrim(t.tag)), btrim(t.tag)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO label_jobs (label_id, job_id)
+SELECT DISTINCT labels.id, jobs.id
+FROM jobs
+JOIN backfilled_jobs b ON b.id = jobs.id, unnest(jobs.tags) AS t(tag), labels
+WHERE labels.user_id = jobs.owner_id AND lower(labels.name) = lower(btrim(t.tag))
+ON CONFLICT DO NOTHING;
+
+DROP TABLE backfilled_jobs;
+
+-- +goose Down
+-- veri düzeltmesi: geri alınmaz (sahip / canonical_url sonradan değişmiş olabilir)
@@ -0,0 +1,15 @@
+-- +goose Up
+-- events.contact_id 20250826'da FK'sız eklendi: silinen kişinin etkileşim event'leri
+-- var olmayan bir kişiyi gösteriyordu. Önce yetimlerin contact_id'si NULL'lanır, sonra
+-- FK eklenir; kişi silinince event'ler kalır, yalnızca contact_id NULL'lanır
+-- (başvuru geçmişi kişiyle birlikte kaybolmasın).
+UPDATE events SET contact_id = NULL
+WHERE contact_id IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM contacts WHERE contacts.id = events.contact_id);
+
+ALTER TABLE events
+  ADD CONSTRAINT events_contact_id_fkey
+  FOREIGN KEY (contact_id) REFERENCES contacts(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_contact_id_fkey;
@@ -0,0 +1,27 @@
+-- +goose Up
+-- Aynı kolonda (user_id, status) iki kart aynı board_rank'i alabiliyordu (en üst rank
+-- kilitsiz okunuyordu); eşit rank'li komşular arasına :move yapılamıyordu. Tekrarlı
+-- rank içeren kolonlar mevcut (rank, id) sırası korunarak yeniden numaralanır
+-- (20250827 ile aynı şema), sonra silinmemiş kartlar için rank unique yapılır.
+UPDATE applications a
+SET board_rank = r.board_rank
+FROM (
+  SELECT id,
+         'a' || lpad(row_number() OVER (PARTITION BY user_id, status ORDER BY board_rank, id)::text, 6, '0') || 'i' AS board_rank
+  FROM applications
+  WHERE deleted_at IS NULL
+    AND (user_id, status) IN (
+      SELECT user_id, status
+      FROM applications
+      WHERE deleted_at IS NULL
+      GROUP BY user_id, status, board_rank
+      HAVING count(*) > 1
+    )
+) r
+WHERE r.id = a.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_applications_board_rank
+  ON applications(user_id, status, board_rank) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS uq_applications_board_rank;
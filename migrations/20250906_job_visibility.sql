@@ -0,0 +1,13 @@
+-- +goose Up
+-- Tek görünürlük kuralı: kullanıcının kendi ilanları + org ilanları (org ilanları herkese
+-- açık yayındır; adaylar başvurur). Öneriler ve ilan alt kaynakları (revizyonlar, etiket /
+-- kişi bağlantıları) bu fonksiyonu kullanır. Export daha dar kapsamlıdır (ListManagedJobsAfter).
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION job_visible_to(job_owner_id BIGINT, job_org_id BIGINT, uid BIGINT) RETURNS BOOLEAN
+LANGUAGE sql IMMUTABLE AS $$
+  SELECT job_org_id IS NOT NULL OR COALESCE(job_owner_id = uid, false)
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP FUNCTION IF EXISTS job_visible_to(BIGINT, BIGINT, BIGINT);